@@ -3,22 +3,35 @@ package brave
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"time"
 )
 
+const defaultBaseURL = "https://api.search.brave.com/res/v1/"
+
+// Endpoint identifies an endpoint of the Brave Search API by its path.
+type Endpoint string
+
 const (
-	defaultBaseURL       = "https://api.search.brave.com/res/v1/"
-	imageSearchPath      = "images/search"
-	spellcheckPath       = "spellcheck/search"
-	suggestSearchPath    = "suggest/search"
-	videoSearchPath      = "videos/search"
-	webSearchPath        = "web/search"
-	summarizerSearchPath = "summarizer/search"
+	EndpointImageSearch       Endpoint = "images/search"
+	EndpointLocalPOIs         Endpoint = "local/pois"
+	EndpointLocalDescriptions Endpoint = "local/descriptions"
+	EndpointSpellcheck        Endpoint = "spellcheck/search"
+	EndpointSuggestSearch     Endpoint = "suggest/search"
+	EndpointVideoSearch       Endpoint = "videos/search"
+	EndpointWebSearch         Endpoint = "web/search"
+	EndpointNewsSearch        Endpoint = "news/search"
+	EndpointSummarizerSearch  Endpoint = "summarizer/search"
+	EndpointSummarizerStream  Endpoint = "summarizer/summary_streaming"
 )
 
 // Brave is an interface for fetching results from the Brave Search API.
+//
+// Search options are checked against the ranges and values documented by the
+// API before a request is sent. Invalid options are reported as an
+// [ErrorResponse] matching [ErrValidation], as the API would report them.
 type Brave interface {
 	// WebSearch returns web search results.
 	WebSearch(ctx context.Context, term string, options ...SearchOption) (*WebSearchResult, error)
@@ -35,14 +48,52 @@ type Brave interface {
 	// VideoSearch returns video search results.
 	VideoSearch(ctx context.Context, term string, options ...SearchOption) (*VideoSearchResult, error)
 
+	// NewsSearch returns news search results.
+	NewsSearch(ctx context.Context, term string, options ...SearchOption) (*NewsSearchResult, error)
+
+	// LocalPOIs returns extra information about the locations with the given
+	// IDs, as found in [LocationResult.ID] of a web search.
+	LocalPOIs(ctx context.Context, ids ...string) (*LocalPOIsResult, error)
+
+	// LocalDescriptions returns AI-generated descriptions of the locations with
+	// the given IDs, as found in [LocationResult.ID] of a web search.
+	LocalDescriptions(ctx context.Context, ids ...string) (*LocalDescriptionsResult, error)
+
 	// SummarizerSearch returns AI summaries of a search result.
 	SummarizerSearch(ctx context.Context, key string, options ...SearchOption) (*SummarizerSearchResult, error)
+
+	// SummarizerStream returns a stream of the messages of the AI summary of a
+	// search result as they are generated.
+	SummarizerStream(ctx context.Context, key string, options ...SearchOption) (*SummaryStream, error)
+
+	// WaitForSummary polls the summarizer until the summary for key is
+	// complete or has failed, or ctx is done. A failed summary is returned
+	// along with [ErrSummaryFailed], and a summary still pending after the
+	// last poll along with [ErrSummaryPending].
+	WaitForSummary(ctx context.Context, key string, options ...SearchOption) (*SummarizerSearchResult, error)
+
+	// WebSearchWithSummary returns web search results for term along with
+	// their completed summary, as returned by WaitForSummary. The summary is
+	// nil if the API did not offer one for term.
+	WebSearchWithSummary(ctx context.Context, term string, options ...SearchOption) (*WebSearchResult, *SummarizerSearchResult, error)
 }
 
 type brave struct {
 	client            *http.Client
 	baseURL           *url.URL
 	subscriptionToken string
+	retry             RetryPolicy
+	summaryPoll       RetryPolicy
+	strictOptions     bool
+	skipValidation    bool
+	limiter           *rateLimiter
+	cache             Cache
+	cacheTTLs         map[Endpoint]time.Duration
+	flights           *flightGroup
+	middleware        []Middleware
+	phaseHooks        []PhaseHook
+	logger            *slog.Logger
+	logLevels         logLevels
 }
 
 func New(subscriptionToken string, options ...ClientOption) (Brave, error) {
@@ -56,6 +107,10 @@ func New(subscriptionToken string, options ...ClientOption) (Brave, error) {
 			o.client = http.DefaultClient
 		}
 
+		if o.logLevels == nil {
+			o.logLevels = &defaultLogLevels
+		}
+
 		return o
 	})
 
@@ -64,10 +119,27 @@ func New(subscriptionToken string, options ...ClientOption) (Brave, error) {
 		return nil, err
 	}
 
+	var flights *flightGroup
+	if opts.coalesce {
+		flights = &flightGroup{}
+	}
+
 	return &brave{
 		client:            opts.client,
 		baseURL:           u,
 		subscriptionToken: subscriptionToken,
+		retry:             opts.retry.withDefaults(),
+		summaryPoll:       opts.summaryPoll.withPollDefaults(),
+		strictOptions:     opts.strictOptions,
+		skipValidation:    opts.skipValidation,
+		limiter:           newRateLimiter(opts.rateLimit, opts.monthlyQuota),
+		cache:             opts.cache,
+		cacheTTLs:         opts.cacheTTLs,
+		flights:           flights,
+		middleware:        opts.middleware,
+		phaseHooks:        opts.phaseHooks,
+		logger:            opts.logger,
+		logLevels:         *opts.logLevels,
 	}, nil
 }
 
@@ -114,6 +186,16 @@ func (r ResultFilter) String() string {
 		return "videos"
 	case ResultFilterWeb:
 		return "web"
+	case ResultFilterImages:
+		return "images"
+	case ResultFilterLocations:
+		return "locations"
+	case ResultFilterQuery:
+		return "query"
+	case ResultFilterSummarizer:
+		return "summarizer"
+	case ResultFilterRich:
+		return "rich"
 	default:
 		return ""
 	}
@@ -172,6 +254,10 @@ const (
 	ResultFilterVideos      ResultFilter = "videos"
 	ResultFilterWeb         ResultFilter = "web"
 	ResultFilterImages      ResultFilter = "images"
+	ResultFilterLocations   ResultFilter = "locations"
+	ResultFilterQuery       ResultFilter = "query"
+	ResultFilterSummarizer  ResultFilter = "summarizer"
+	ResultFilterRich        ResultFilter = "rich"
 )
 
 const (
@@ -218,6 +304,9 @@ type searchOptions struct {
 	apiVersion      string
 	summary         bool
 	entityInfo      bool
+
+	// set records which of the query parameter options have been set.
+	set optionSet
 }
 
 func (s searchOptions) getFreshness() string {
@@ -245,63 +334,63 @@ func (s searchOptions) getResultFilter() []string {
 
 	strs := make([]string, 0, len(s.resultFilter))
 	for _, r := range s.resultFilter {
-		strs = append(strs, r.String())
+		strs = append(strs, string(r))
 	}
 
 	return strs
 }
 
-func (s searchOptions) applyRequestHeaders(subscriptionToken string, req *http.Request) {
-	req.Header.Add("X-Subscription-Token", subscriptionToken)
-
+func (s searchOptions) applyRequestHeaders(h http.Header) {
 	if s.noCache {
-		req.Header.Add("Cache-Control", "no-cache")
+		h.Add("Cache-Control", "no-cache")
 	}
 
 	if s.userAgent != "" {
-		req.Header.Add("User-Agent", s.userAgent)
+		h.Add("User-Agent", s.userAgent)
 	}
 
 	if s.locLatitude != nil {
-		req.Header.Add("X-Loc-Lat", fmt.Sprintf("%.3f", *s.locLatitude))
+		h.Add("X-Loc-Lat", fmt.Sprintf("%.3f", *s.locLatitude))
 	}
 
 	if s.locLongitude != nil {
-		req.Header.Add("X-Loc-Long", fmt.Sprintf("%.3f", *s.locLongitude))
+		h.Add("X-Loc-Long", fmt.Sprintf("%.3f", *s.locLongitude))
 	}
 
 	if s.locTimezone != nil {
-		req.Header.Add("X-Loc-Timezone", s.locTimezone.String())
+		h.Add("X-Loc-Timezone", s.locTimezone.String())
 	}
 
 	if s.locCity != "" {
-		req.Header.Add("X-Loc-City", s.locCity)
+		h.Add("X-Loc-City", s.locCity)
 	}
 
 	if s.locState != "" {
-		req.Header.Add("X-Loc-State", s.locState)
+		h.Add("X-Loc-State", s.locState)
 	}
 
 	if s.locStateName != "" {
-		req.Header.Add("X-Loc-State-Name", s.locStateName)
+		h.Add("X-Loc-State-Name", s.locStateName)
 	}
 
 	if s.locCountry != "" {
-		req.Header.Add("X-Loc-Country", s.locCountry)
+		h.Add("X-Loc-Country", s.locCountry)
 	}
 
 	if s.locPostalCode != "" {
-		req.Header.Add("X-Loc-Postal-Code", s.locPostalCode)
+		h.Add("X-Loc-Postal-Code", s.locPostalCode)
 	}
 
 	if s.apiVersion != "" {
-		req.Header.Add("Api-Version", s.apiVersion)
+		h.Add("Api-Version", s.apiVersion)
 	}
 }
 
 // WithCountry specifies the search query country, where the results come from.
+// Use [ParseCountry] or [WithCountryCode] to avoid unsupported values.
 //
-// Applicable to [Brave.WebSearch], [Brave.SuggestSearch], [Brave.Spellcheck].
+// Applicable to [Brave.WebSearch], [Brave.ImageSearch], [Brave.VideoSearch],
+// [Brave.NewsSearch], [Brave.SuggestSearch], [Brave.Spellcheck].
 //
 // Refer to [Query Parameters] for more detail.
 //
@@ -309,13 +398,21 @@ func (s searchOptions) applyRequestHeaders(subscriptionToken string, req *http.R
 func WithCountry(v string) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.country = v
+		o.set |= optCountry
 		return o
 	}
 }
 
-// WithLang specifies the search language preference.
+// WithCountryCode is like [WithCountry], but takes a [Country].
+func WithCountryCode(v Country) SearchOption {
+	return WithCountry(string(v))
+}
+
+// WithLang specifies the search language preference. Use [ParseSearchLang] or
+// [WithLangCode] to avoid unsupported values.
 //
-// Applicable to [Brave.WebSearch] (as `search_lang`), [Brave.SuggestSearch],
+// Applicable to [Brave.WebSearch], [Brave.ImageSearch], [Brave.VideoSearch]
+// and [Brave.NewsSearch] (as `search_lang`), [Brave.SuggestSearch],
 // [Brave.Spellcheck].
 //
 // Refer to [Query Parameters] for more detail.
@@ -324,13 +421,20 @@ func WithCountry(v string) SearchOption {
 func WithLang(v string) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.lang = v
+		o.set |= optLang
 		return o
 	}
 }
 
-// WithUILang specifies the user interface language preferred in response.
+// WithLangCode is like [WithLang], but takes a [SearchLang].
+func WithLangCode(v SearchLang) SearchOption {
+	return WithLang(string(v))
+}
+
+// WithUILang specifies the user interface language preferred in response. Use
+// [ParseUILang] or [WithUILangCode] to avoid unsupported values.
 //
-// Applicable to [Brave.WebSearch].
+// Applicable to [Brave.WebSearch], [Brave.VideoSearch], [Brave.NewsSearch].
 //
 // Refer to [Query Parameters] for more detail.
 //
@@ -338,13 +442,22 @@ func WithLang(v string) SearchOption {
 func WithUILang(v string) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.uiLang = v
+		o.set |= optUILang
 		return o
 	}
 }
 
-// WithCount specifies the number of search results returned in response.
+// WithUILangCode is like [WithUILang], but takes a [UILang].
+func WithUILangCode(v UILang) SearchOption {
+	return WithUILang(string(v))
+}
+
+// WithCount specifies the number of search results returned in response. The
+// maximum depends on the endpoint: 200 for image search, 50 for video and news
+// search, and 20 for the others.
 //
-// Applicable to [Brave.WebSearch], [Brave.SuggestSearch].
+// Applicable to [Brave.WebSearch], [Brave.ImageSearch], [Brave.VideoSearch],
+// [Brave.NewsSearch], [Brave.SuggestSearch].
 //
 // Refer to [Query Parameters] for more detail.
 //
@@ -352,6 +465,7 @@ func WithUILang(v string) SearchOption {
 func WithCount(v int) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.count = v
+		o.set |= optCount
 		return o
 	}
 }
@@ -359,7 +473,7 @@ func WithCount(v int) SearchOption {
 // WithOffset specifies the zero based offset that indicates number of search
 // result per page (count) to skip before returning the result.
 //
-// Applicable to [Brave.WebSearch].
+// Applicable to [Brave.WebSearch], [Brave.VideoSearch], [Brave.NewsSearch].
 //
 // Refer to [Query Parameters] for more detail.
 //
@@ -367,13 +481,16 @@ func WithCount(v int) SearchOption {
 func WithOffset(v int) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.offset = v
+		o.set |= optOffset
 		return o
 	}
 }
 
-// WithSafesearch filters search results for adult content.
+// WithSafesearch filters search results for adult content. Image search only
+// supports [SafesearchOff] and [SafesearchStrict], and defaults to strict.
 //
-// Applicable to [Brave.WebSearch].
+// Applicable to [Brave.WebSearch], [Brave.ImageSearch], [Brave.VideoSearch],
+// [Brave.NewsSearch].
 //
 // Refer to [Query Parameters] for more detail.
 //
@@ -381,6 +498,7 @@ func WithOffset(v int) SearchOption {
 func WithSafesearch(v Safesearch) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.safesearch = v
+		o.set |= optSafesearch
 		return o
 	}
 }
@@ -388,7 +506,7 @@ func WithSafesearch(v Safesearch) SearchOption {
 // WithFreshness filters search results by when they were discovered.
 // To set a custom timeframe, use [WithCustomFreshness].
 //
-// Applicable to [Brave.WebSearch].
+// Applicable to [Brave.WebSearch], [Brave.VideoSearch], [Brave.NewsSearch].
 //
 // Refer to [Query Parameters] for more detail.
 //
@@ -396,6 +514,7 @@ func WithSafesearch(v Safesearch) SearchOption {
 func WithFreshness(v Freshness) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.freshness = v
+		o.set |= optFreshness
 		return o
 	}
 }
@@ -403,7 +522,7 @@ func WithFreshness(v Freshness) SearchOption {
 // WithCustomFreshness filters search results by a specified timeframe in which
 // the result was discovered. To use a known value, use [WithFreshness].
 //
-// Applicable to [Brave.WebSearch].
+// Applicable to [Brave.WebSearch], [Brave.VideoSearch], [Brave.NewsSearch].
 //
 // Refer to [Query Parameters] for more detail.
 //
@@ -411,6 +530,7 @@ func WithFreshness(v Freshness) SearchOption {
 func WithCustomFreshness(start time.Time, end time.Time) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.customFreshness = []time.Time{start, end}
+		o.set |= optCustomFreshness
 		return o
 	}
 }
@@ -427,6 +547,7 @@ func WithCustomFreshness(start time.Time, end time.Time) SearchOption {
 func WithTextDecorations(v bool) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.textDecorations = v
+		o.set |= optTextDecorations
 		return o
 	}
 }
@@ -442,6 +563,7 @@ func WithTextDecorations(v bool) SearchOption {
 func WithResultFilter(v ...ResultFilter) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.resultFilter = v
+		o.set |= optResultFilter
 		return o
 	}
 }
@@ -456,6 +578,7 @@ func WithResultFilter(v ...ResultFilter) SearchOption {
 func WithGogglesID(v string) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.gogglesID = v
+		o.set |= optGogglesID
 		return o
 	}
 }
@@ -470,6 +593,7 @@ func WithGogglesID(v string) SearchOption {
 func WithUnits(v UnitType) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.units = v
+		o.set |= optUnits
 		return o
 	}
 }
@@ -477,7 +601,7 @@ func WithUnits(v UnitType) SearchOption {
 // WithExtraSnippets specifies whether to return extra alternate snippets for
 // web search results. Defaults to `false`.
 //
-// Applicable to [Brave.WebSearch].
+// Applicable to [Brave.WebSearch], [Brave.NewsSearch].
 //
 // Refer to [Query Parameters] for more detail.
 //
@@ -485,6 +609,7 @@ func WithUnits(v UnitType) SearchOption {
 func WithExtraSnippets(v bool) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.extraSnippets = v
+		o.set |= optExtraSnippets
 		return o
 	}
 }
@@ -500,12 +625,13 @@ func WithExtraSnippets(v bool) SearchOption {
 func WithRich(v bool) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.rich = v
+		o.set |= optRich
 		return o
 	}
 }
 
 // WithNoCache specifies whether to disable server caching of results. Defaults
-// to `false`.
+// to `false`. It also bypasses the client's cache configured with [WithCache].
 //
 // Applicable to [Brave.WebSearch], [Brave.SuggestSearch], [Brave.Spellcheck].
 //
@@ -650,12 +776,16 @@ func WithLocPostalCode(v string) SearchOption {
 
 // WithSpellcheck toggles spellchecking on or off.
 //
+// Applicable to [Brave.WebSearch], [Brave.ImageSearch], [Brave.VideoSearch],
+// [Brave.NewsSearch].
+//
 // Refer to [Query Parameters] for more detail.
 //
 // [Query Parameters]: https://api.search.brave.com/app/documentation/query
 func WithSpellcheck(v bool) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.spellcheck = &v
+		o.set |= optSpellcheck
 		return o
 	}
 }
@@ -682,13 +812,14 @@ func WithAPIVersion(v string) SearchOption {
 func WithSummary(v bool) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.summary = v
+		o.set |= optSummary
 		return o
 	}
 }
 
 // WithEntityInfo specifies whether entity information should be returned
 //
-// Applicable to [Brave.SummarizerSearch].
+// Applicable to [Brave.SummarizerSearch], [Brave.SummarizerStream].
 //
 // Refer to [Query Parameters] for more detail.
 //
@@ -696,6 +827,7 @@ func WithSummary(v bool) SearchOption {
 func WithEntityInfo(v bool) SearchOption {
 	return func(o searchOptions) searchOptions {
 		o.entityInfo = v
+		o.set |= optEntityInfo
 		return o
 	}
 }
@@ -706,6 +838,26 @@ type ClientOption func(clientOptions) clientOptions
 type clientOptions struct {
 	baseURL string
 	client  *http.Client
+	retry   RetryPolicy
+
+	summaryPoll RetryPolicy
+
+	strictOptions  bool
+	skipValidation bool
+
+	rateLimit    float64
+	monthlyQuota int
+
+	cache     Cache
+	cacheTTLs map[Endpoint]time.Duration
+
+	coalesce bool
+
+	middleware []Middleware
+	phaseHooks []PhaseHook
+
+	logger    *slog.Logger
+	logLevels *logLevels
 }
 
 // WithBaseURL overrides the default URL of the Brave API client.
@@ -728,6 +880,110 @@ func WithHTTPClient(v *http.Client) ClientOption {
 	}
 }
 
+// WithRetryPolicy enables retrying of rate-limited and transient failures.
+// Retries are scheduled using the `Retry-After` response header when present,
+// the `X-RateLimit-Reset` header when the rate limit has been exceeded, and
+// jittered exponential backoff otherwise.
+// A retry is never scheduled past the request context's deadline.
+//
+// If not provided, requests are not retried.
+func WithRetryPolicy(v RetryPolicy) ClientOption {
+	return func(o clientOptions) clientOptions {
+		o.retry = v
+		return o
+	}
+}
+
+// WithSummaryPolling controls how [Brave.WaitForSummary] polls the summarizer.
+// Polls are spaced using the jittered exponential backoff of the policy, and
+// MaxAttempts limits the number of polls, after which [ErrSummaryPending] is
+// returned. A MaxAttempts of zero polls up to 10 times, and a negative
+// MaxAttempts polls until the summary is ready or the context is done.
+//
+// If not provided, the summarizer is polled up to 10 times, with the default
+// delays of [RetryPolicy].
+func WithSummaryPolling(v RetryPolicy) ClientOption {
+	return func(o clientOptions) clientOptions {
+		o.summaryPoll = v
+		return o
+	}
+}
+
+// WithRateLimit limits the rate of requests sent by the client to match the
+// subscription plan, which is useful when many goroutines share a single
+// subscription token. perSecond limits the requests per second and
+// monthlyQuota the number of requests per month; zero disables either limit.
+//
+// Requests wait for the per-second limit unless the context's deadline would
+// pass first, in which case they fail with [ErrRateLimited]. Once the monthly
+// quota is used up, requests fail with [ErrQuotaExceeded]. Both limits adjust
+// themselves from the `X-RateLimit-Limit` and `X-RateLimit-Remaining` response
+// headers.
+//
+// If not provided, requests are not rate limited.
+func WithRateLimit(perSecond float64, monthlyQuota int) ClientOption {
+	return func(o clientOptions) clientOptions {
+		o.rateLimit = perSecond
+		o.monthlyQuota = monthlyQuota
+		return o
+	}
+}
+
+// WithCache caches the results of [Brave.WebSearch], [Brave.ImageSearch],
+// [Brave.VideoSearch], [Brave.NewsSearch], [Brave.SuggestSearch],
+// [Brave.Spellcheck], [Brave.LocalPOIs] and [Brave.LocalDescriptions] in c.
+// Results are keyed by endpoint, query parameters and location headers, and
+// are kept for five minutes unless configured otherwise with [WithCacheTTL].
+// Requests made with [WithNoCache] skip the cache lookup, but still refresh
+// the cached result.
+//
+// Use [NewLRUCache] for an in-memory cache.
+func WithCache(c Cache) ClientOption {
+	return func(o clientOptions) clientOptions {
+		o.cache = c
+		return o
+	}
+}
+
+// WithCacheTTL sets how long results of endpoint are cached when a cache is
+// configured with [WithCache]. A zero or negative ttl disables caching for the
+// endpoint.
+func WithCacheTTL(endpoint Endpoint, ttl time.Duration) ClientOption {
+	return func(o clientOptions) clientOptions {
+		ttls := make(map[Endpoint]time.Duration, len(o.cacheTTLs)+1)
+		for k, v := range o.cacheTTLs {
+			ttls[k] = v
+		}
+
+		ttls[endpoint] = ttl
+		o.cacheTTLs = ttls
+		return o
+	}
+}
+
+// WithRequestCoalescing specifies whether concurrent identical requests should
+// be coalesced into a single request to the API. Requests are identical if
+// they have the same URL and headers. All callers receive the same result,
+// which must therefore not be modified.
+//
+// The shared request is only cancelled once the contexts of all waiting
+// callers have been cancelled.
+func WithRequestCoalescing(v bool) ClientOption {
+	return func(o clientOptions) clientOptions {
+		o.coalesce = v
+		return o
+	}
+}
+
+// WithClientOptions combines multiple options into one. This is useful for
+// packages providing integrations that need to set several options.
+func WithClientOptions(v ...ClientOption) ClientOption {
+	return func(o clientOptions) clientOptions {
+		applyOpts(&o, v, nil)
+		return o
+	}
+}
+
 func applyOpts[T any, F ~func(T) T](cfg *T, opts []F, setDefaults F) {
 	for _, opt := range opts {
 		if opt == nil {
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -91,6 +92,198 @@ func TestVideo(t *testing.T) {
 	assert.NotNil(t, res)
 }
 
+func TestQueryParams(t *testing.T) {
+	var rawQuery string
+	svr := getQueryServer(t, "", &rawQuery)
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	options := []brave.SearchOption{
+		brave.WithCountry("us"),
+		brave.WithLang("en"),
+		brave.WithUILang("en-US"),
+		brave.WithCount(20),
+		brave.WithOffset(2),
+		brave.WithFreshness(brave.FreshnessPastWeek),
+		brave.WithSpellcheck(false),
+		brave.WithResultFilter(brave.ResultFilterWeb),
+		brave.WithGogglesID("https://example.com/goggle"),
+		brave.WithUnits(brave.UnitTypeMetric),
+		brave.WithSummary(true),
+	}
+
+	tests := []struct {
+		name   string
+		search func(ctx context.Context, term string, options ...brave.SearchOption) error
+		want   string
+	}{
+		{
+			name: "web",
+			search: func(ctx context.Context, term string, options ...brave.SearchOption) error {
+				_, err := client.WebSearch(ctx, term, options...)
+				return err
+			},
+			want: "count=20&country=us&freshness=pw&goggles_id=https%3A%2F%2Fexample.com%2Fgoggle&offset=2&q=cats&result_filter=web&safesearch=moderate&search_lang=en&spellcheck=false&summary=true&ui_lang=en-US&units=metric",
+		},
+		{
+			name: "images",
+			search: func(ctx context.Context, term string, options ...brave.SearchOption) error {
+				_, err := client.ImageSearch(ctx, term, options...)
+				return err
+			},
+			want: "count=20&country=us&q=cats&search_lang=en&spellcheck=false",
+		},
+		{
+			name: "videos",
+			search: func(ctx context.Context, term string, options ...brave.SearchOption) error {
+				_, err := client.VideoSearch(ctx, term, options...)
+				return err
+			},
+			want: "count=20&country=us&freshness=pw&offset=2&q=cats&safesearch=moderate&search_lang=en&spellcheck=false&ui_lang=en-US",
+		},
+		{
+			name: "news",
+			search: func(ctx context.Context, term string, options ...brave.SearchOption) error {
+				_, err := client.NewsSearch(ctx, term, options...)
+				return err
+			},
+			want: "count=20&country=us&freshness=pw&offset=2&q=cats&safesearch=moderate&search_lang=en&spellcheck=false&ui_lang=en-US",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Nil(t, tt.search(context.Background(), "cats", options...))
+			assert.Equal(t, tt.want, rawQuery)
+		})
+	}
+}
+
+func TestImageSafesearch(t *testing.T) {
+	var rawQuery string
+	svr := getQueryServer(t, "", &rawQuery)
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	_, err = client.ImageSearch(context.Background(), "cats", brave.WithSafesearch(brave.SafesearchOff))
+	require.Nil(t, err)
+	assert.Equal(t, "q=cats&safesearch=off", rawQuery)
+
+	_, err = client.ImageSearch(context.Background(), "cats", brave.WithCount(200))
+	require.Nil(t, err)
+	assert.Equal(t, "count=200&q=cats", rawQuery)
+
+	// moderate is not supported by image search, and is never sent.
+	rawQuery = ""
+	_, err = client.ImageSearch(context.Background(), "cats", brave.WithSafesearch(brave.SafesearchModerate))
+	assert.ErrorIs(t, err, brave.ErrValidation)
+	assert.Empty(t, rawQuery)
+}
+
+func TestNews(t *testing.T) {
+	var rawQuery string
+	svr := getQueryServer(t, "testdata/news.json", &rawQuery)
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL+"/"))
+	require.Nil(t, err)
+
+	res, err := client.NewsSearch(context.Background(), "speaker of the house",
+		brave.WithCount(2),
+		brave.WithFreshness(brave.FreshnessPastDay),
+		brave.WithExtraSnippets(true),
+	)
+	require.Nil(t, err)
+
+	assert.Equal(t, "count=2&extra_snippets=true&freshness=pd&q=speaker+of+the+house&safesearch=moderate", rawQuery)
+	assert.Equal(t, "news", res.Type)
+	require.NotNil(t, res.Query)
+	assert.Equal(t, "speaker of the house", res.Query.Original)
+	require.Len(t, res.Results, 2)
+
+	r := res.Results[0]
+	assert.Equal(t, "https://www.example.com/politics/house-speaker-vote", r.URL)
+	assert.True(t, r.Breaking)
+	assert.Equal(t, "www.example.com", r.MetaURL.Hostname)
+	assert.Len(t, r.ExtraSnippets, 2)
+	assert.False(t, r.Age.Time().IsZero())
+}
+
+func TestLocalPOIs(t *testing.T) {
+	var (
+		path string
+		ids  []string
+	)
+
+	body, err := os.ReadFile("testdata/local_pois.json")
+	require.Nil(t, err)
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		ids = r.URL.Query()["ids"]
+		_, _ = w.Write(body)
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL+"/"))
+	require.Nil(t, err)
+
+	res, err := client.LocalPOIs(context.Background(),
+		"loc4CQWMJWLD4VBEBZ62XQLJTGK6YCJEEJDNAAAAAAA=",
+		"loc4HTAVTJKP4RBEBZCEMBI3NG26YD4II4PATIHPDYI=",
+	)
+	require.Nil(t, err)
+
+	assert.Equal(t, "/local/pois", path)
+	assert.Equal(t, []string{"loc4CQWMJWLD4VBEBZ62XQLJTGK6YCJEEJDNAAAAAAA=", "loc4HTAVTJKP4RBEBZCEMBI3NG26YD4II4PATIHPDYI="}, ids)
+
+	assert.Equal(t, "local_pois", res.Type)
+	require.Len(t, res.Results, 2)
+	assert.Equal(t, "loc4CQWMJWLD4VBEBZ62XQLJTGK6YCJEEJDNAAAAAAA=", res.Results[0].ID)
+	assert.Equal(t, "Bea's Diner", res.Results[0].Title)
+	require.NotNil(t, res.Results[0].PostalAddress)
+	assert.Equal(t, "Detroit", res.Results[0].PostalAddress.AddressLocality)
+}
+
+func TestLocalDescriptions(t *testing.T) {
+	var path string
+
+	body, err := os.ReadFile("testdata/local_descriptions.json")
+	require.Nil(t, err)
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		_, _ = w.Write(body)
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL+"/"))
+	require.Nil(t, err)
+
+	res, err := client.LocalDescriptions(context.Background(), "loc4CQWMJWLD4VBEBZ62XQLJTGK6YCJEEJDNAAAAAAA=")
+	require.Nil(t, err)
+
+	assert.Equal(t, "/local/descriptions", path)
+	require.Len(t, res.Results, 1)
+	assert.Equal(t, "loc4CQWMJWLD4VBEBZ62XQLJTGK6YCJEEJDNAAAAAAA=", res.Results[0].ID)
+	assert.Contains(t, res.Results[0].Description, "classic American diner")
+}
+
+func TestLocalNoIDs(t *testing.T) {
+	client, err := brave.New("fake", brave.WithBaseURL("http://127.0.0.1:0/"))
+	require.Nil(t, err)
+
+	_, err = client.LocalPOIs(context.Background())
+	assert.ErrorIs(t, err, brave.ErrValidation)
+
+	_, err = client.LocalDescriptions(context.Background(), make([]string, 21)...)
+	assert.ErrorIs(t, err, brave.ErrValidation)
+}
+
 func TestDuration(t *testing.T) {
 	var getDuration = func(in string) brave.Duration {
 		d, err := time.ParseDuration(in)
@@ -149,6 +342,74 @@ func TestRecipe(t *testing.T) {
 	assert.Equal(t, 40*time.Minute, *r.Recipe.Time.Duration())
 }
 
+func TestResponseMeta(t *testing.T) {
+	body, err := os.ReadFile("testdata/images.json")
+	require.Nil(t, err)
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "abc123")
+		w.Header().Set("X-RateLimit-Limit", "1, 15000")
+		w.Header().Set("X-RateLimit-Remaining", "0, 1000")
+		w.Header().Set("X-RateLimit-Reset", "1, 1419704")
+		_, _ = w.Write(body)
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	res, err := client.ImageSearch(context.Background(), "speaker of the house")
+	require.Nil(t, err)
+
+	meta := res.ResponseMeta()
+	require.NotNil(t, meta)
+	assert.Equal(t, http.StatusOK, meta.StatusCode)
+	assert.Equal(t, "abc123", meta.RequestID)
+	assert.Equal(t, &brave.RateLimitWindow{Limit: 1, Remaining: 0, Reset: time.Second}, meta.RateLimit.PerSecond)
+	assert.Equal(t, &brave.RateLimitWindow{Limit: 15000, Remaining: 1000, Reset: 1419704 * time.Second}, meta.RateLimit.PerMonth)
+	assert.Positive(t, meta.Latency)
+}
+
+func TestNonJSONError(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("<html><body>" + strings.Repeat("bad gateway ", 1000) + "</body></html>"))
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "speaker of the house")
+
+	var httpErr brave.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusBadGateway, httpErr.StatusCode)
+	assert.Equal(t, "text/html", httpErr.ContentType)
+	assert.True(t, strings.HasPrefix(httpErr.Body, "<html><body>bad gateway"))
+	assert.Less(t, len(httpErr.Body), 1024)
+	assert.ErrorIs(t, err, brave.ErrUpstream)
+}
+
+func TestEmptyError(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "speaker of the house")
+
+	var httpErr brave.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusUnauthorized, httpErr.StatusCode)
+	assert.Empty(t, httpErr.Body)
+	assert.ErrorIs(t, err, brave.ErrUnauthorized)
+}
+
 func getTestServer(file string, status int) *httptest.Server {
 	body, err := os.ReadFile(file)
 	if err != nil {
@@ -165,3 +426,20 @@ func getTestServer(file string, status int) *httptest.Server {
 		_, _ = w.Write(body)
 	}))
 }
+
+// getQueryServer returns a server that records the raw query of each request
+// in rawQuery and responds with the contents of file, or with an empty object
+// if file is empty.
+func getQueryServer(t *testing.T, file string, rawQuery *string) *httptest.Server {
+	body := []byte(`{}`)
+	if file != "" {
+		var err error
+		body, err = os.ReadFile(file)
+		require.Nil(t, err)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*rawQuery = r.URL.RawQuery
+		_, _ = w.Write(body)
+	}))
+}
@@ -0,0 +1,179 @@
+// Package bravemetrics provides Prometheus metrics for the Brave Search API
+// client.
+//
+//	m, err := bravemetrics.New(prometheus.DefaultRegisterer)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	client, err := brave.New(token, m.ClientOption())
+//
+// The package is a separate module, so that the client itself does not depend
+// on Prometheus.
+package bravemetrics
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "brave"
+
+// Metrics holds the collectors instrumenting one or more clients.
+type Metrics struct {
+	requests     *prometheus.CounterVec
+	latency      *prometheus.HistogramVec
+	decodeErrors *prometheus.CounterVec
+	cache        *prometheus.CounterVec
+	limit        *prometheus.GaugeVec
+	remaining    *prometheus.GaugeVec
+}
+
+// New creates the collectors and registers them with reg.
+func New(reg prometheus.Registerer) (*Metrics, error) {
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Calls to the Brave Search API by endpoint and response status.",
+		}, []string{"endpoint", "status"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of HTTP round trips to the Brave Search API, including retries.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"endpoint"}),
+		decodeErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "decode_errors_total",
+			Help:      "Responses from the Brave Search API that could not be decoded.",
+		}, []string{"endpoint"}),
+		cache: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_lookups_total",
+			Help:      "Successful calls looked up in the client's cache by whether they were found.",
+		}, []string{"endpoint", "result"}),
+		limit: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "rate_limit_limit",
+			Help:      "Request limit of the subscription plan by rate-limit window.",
+		}, []string{"window"}),
+		remaining: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "rate_limit_remaining",
+			Help:      "Remaining requests of the subscription plan by rate-limit window.",
+		}, []string{"window"}),
+	}
+
+	for _, c := range []prometheus.Collector{m.requests, m.latency, m.decodeErrors, m.cache, m.limit, m.remaining} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// ClientOption returns a client option that records metrics for every call
+// made by the client.
+func (m *Metrics) ClientOption() brave.ClientOption {
+	return brave.WithClientOptions(
+		brave.WithMiddleware(m.middleware),
+		brave.WithPhaseHook(m.phaseHook),
+	)
+}
+
+func (m *Metrics) middleware(next brave.Handler) brave.Handler {
+	return func(ctx context.Context, req *brave.Request) (any, error) {
+		res, err := next(ctx, req)
+
+		endpoint := string(req.Endpoint)
+		if err != nil {
+			m.requests.WithLabelValues(endpoint, errorStatus(err)).Inc()
+			return res, err
+		}
+
+		meta := responseMeta(res)
+		if meta == nil {
+			m.requests.WithLabelValues(endpoint, "ok").Inc()
+			return res, nil
+		}
+
+		m.requests.WithLabelValues(endpoint, strconv.Itoa(meta.StatusCode)).Inc()
+		if meta.Cached {
+			m.cache.WithLabelValues(endpoint, "hit").Inc()
+			return res, nil
+		}
+
+		if meta.CacheMiss {
+			m.cache.WithLabelValues(endpoint, "miss").Inc()
+		}
+
+		m.observeRateLimit("second", meta.RateLimit.PerSecond)
+		m.observeRateLimit("month", meta.RateLimit.PerMonth)
+
+		return res, nil
+	}
+}
+
+func (m *Metrics) phaseHook(ctx context.Context, endpoint brave.Endpoint, phase brave.Phase) (context.Context, func(error)) {
+	switch phase {
+	case brave.PhaseRoundTrip:
+		start := time.Now()
+		return ctx, func(error) {
+			m.latency.WithLabelValues(string(endpoint)).Observe(time.Since(start).Seconds())
+		}
+	case brave.PhaseDecode:
+		return ctx, func(err error) {
+			if err != nil {
+				m.decodeErrors.WithLabelValues(string(endpoint)).Inc()
+			}
+		}
+	default:
+		return ctx, nil
+	}
+}
+
+func (m *Metrics) observeRateLimit(window string, w *brave.RateLimitWindow) {
+	if w == nil {
+		return
+	}
+
+	m.limit.WithLabelValues(window).Set(float64(w.Limit))
+	m.remaining.WithLabelValues(window).Set(float64(w.Remaining))
+}
+
+// errorStatus returns the status label for a failed call: the HTTP status if
+// the API responded, or a short description of the failure otherwise.
+func errorStatus(err error) string {
+	var resp brave.ErrorResponse
+	var httpErr brave.HTTPError
+
+	switch {
+	case errors.As(err, &resp) && resp.Status != 0:
+		return strconv.Itoa(resp.Status)
+	case errors.As(err, &httpErr):
+		return strconv.Itoa(httpErr.StatusCode)
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return "canceled"
+	case errors.Is(err, brave.ErrRateLimited):
+		return "rate_limited"
+	case errors.Is(err, brave.ErrQuotaExceeded):
+		return "quota_exceeded"
+	default:
+		return "error"
+	}
+}
+
+func responseMeta(res any) *brave.ResponseMeta {
+	if r, ok := res.(interface{ ResponseMeta() *brave.ResponseMeta }); ok {
+		return r.ResponseMeta()
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package bravemetrics_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"dev.freespoke.com/brave-search"
+	"dev.freespoke.com/brave-search/bravemetrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	body, err := os.ReadFile("../testdata/web_0.json")
+	require.Nil(t, err)
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("q") == "broken" {
+			_, _ = w.Write([]byte(`{"type": "search", "query": 1`))
+			return
+		}
+
+		w.Header().Set("X-RateLimit-Limit", "1, 15000")
+		w.Header().Set("X-RateLimit-Remaining", "0, 1000")
+		w.Header().Set("X-RateLimit-Reset", "1, 1419704")
+		_, _ = w.Write(body)
+	}))
+	defer svr.Close()
+
+	reg := prometheus.NewPedanticRegistry()
+	m, err := bravemetrics.New(reg)
+	require.Nil(t, err)
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithCache(brave.NewLRUCache(10, 0)),
+		m.ClientOption(),
+	)
+	require.Nil(t, err)
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		_, err = client.WebSearch(ctx, "speaker of the house")
+		require.Nil(t, err)
+	}
+
+	_, err = client.WebSearch(ctx, "broken")
+	require.NotNil(t, err)
+
+	expected := `
+# HELP brave_cache_lookups_total Successful calls looked up in the client's cache by whether they were found.
+# TYPE brave_cache_lookups_total counter
+brave_cache_lookups_total{endpoint="web/search",result="hit"} 1
+brave_cache_lookups_total{endpoint="web/search",result="miss"} 1
+# HELP brave_decode_errors_total Responses from the Brave Search API that could not be decoded.
+# TYPE brave_decode_errors_total counter
+brave_decode_errors_total{endpoint="web/search"} 1
+# HELP brave_rate_limit_remaining Remaining requests of the subscription plan by rate-limit window.
+# TYPE brave_rate_limit_remaining gauge
+brave_rate_limit_remaining{window="month"} 1000
+brave_rate_limit_remaining{window="second"} 0
+# HELP brave_requests_total Calls to the Brave Search API by endpoint and response status.
+# TYPE brave_requests_total counter
+brave_requests_total{endpoint="web/search",status="200"} 2
+brave_requests_total{endpoint="web/search",status="error"} 1
+`
+
+	assert.Nil(t, testutil.GatherAndCompare(reg, strings.NewReader(expected),
+		"brave_cache_lookups_total",
+		"brave_decode_errors_total",
+		"brave_rate_limit_remaining",
+		"brave_requests_total",
+	))
+	assert.Equal(t, 1, testutil.CollectAndCount(reg, "brave_request_duration_seconds"))
+}
+
+func TestMetricsWithoutCache(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"type": "search"}`))
+	}))
+	defer svr.Close()
+
+	reg := prometheus.NewPedanticRegistry()
+	m, err := bravemetrics.New(reg)
+	require.Nil(t, err)
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		m.ClientOption(),
+	)
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "speaker of the house")
+	require.Nil(t, err)
+
+	assert.Equal(t, 1, testutil.CollectAndCount(reg, "brave_requests_total"))
+	assert.Equal(t, 0, testutil.CollectAndCount(reg, "brave_cache_lookups_total"))
+}
@@ -0,0 +1,207 @@
+// Package braveotel provides OpenTelemetry tracing for the Brave Search API
+// client.
+//
+// Each call to a [brave.Brave] method is recorded as a span, with child spans
+// for building the request, the HTTP round trip, and decoding the response:
+//
+//	client, err := brave.New(token, braveotel.WithTracing())
+//
+// The package is a separate module, so that the client itself does not depend
+// on OpenTelemetry.
+package braveotel
+
+import (
+	"context"
+	"errors"
+
+	"dev.freespoke.com/brave-search"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "dev.freespoke.com/brave-search/braveotel"
+
+// Attribute keys set on spans.
+const (
+	EndpointKey     = attribute.Key("brave.endpoint")
+	PhaseKey        = attribute.Key("brave.phase")
+	GogglesKey      = attribute.Key("brave.goggles")
+	QueryAlteredKey = attribute.Key("brave.query.altered")
+	ErrorCodeKey    = attribute.Key("brave.error.code")
+	StatusCodeKey   = attribute.Key("http.response.status_code")
+	CachedKey       = attribute.Key("brave.cached")
+)
+
+// Option configures the tracing integration.
+type Option func(*config)
+
+type config struct {
+	provider trace.TracerProvider
+}
+
+// WithTracerProvider sets the tracer provider used to create spans. Defaults
+// to the global provider.
+func WithTracerProvider(v trace.TracerProvider) Option {
+	return func(c *config) {
+		c.provider = v
+	}
+}
+
+// WithTracing returns a client option that records a span for every call made
+// by the client. The spans are children of the span in the context passed to
+// the client.
+func WithTracing(opts ...Option) brave.ClientOption {
+	var cfg config
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+
+	if cfg.provider == nil {
+		cfg.provider = otel.GetTracerProvider()
+	}
+
+	tracer := cfg.provider.Tracer(tracerName)
+
+	return brave.WithClientOptions(
+		brave.WithMiddleware(middleware(tracer)),
+		brave.WithPhaseHook(phaseHook(tracer)),
+	)
+}
+
+func middleware(tracer trace.Tracer) brave.Middleware {
+	return func(next brave.Handler) brave.Handler {
+		return func(ctx context.Context, req *brave.Request) (any, error) {
+			ctx, span := tracer.Start(ctx, "brave "+string(req.Endpoint),
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					EndpointKey.String(string(req.Endpoint)),
+					GogglesKey.Bool(req.Params.Get("goggles_id") != ""),
+				),
+			)
+			defer span.End()
+
+			res, err := next(ctx, req)
+			if err != nil {
+				recordError(span, err)
+				return res, err
+			}
+
+			span.SetAttributes(resultAttributes(res)...)
+			return res, nil
+		}
+	}
+}
+
+func phaseHook(tracer trace.Tracer) brave.PhaseHook {
+	return func(ctx context.Context, endpoint brave.Endpoint, phase brave.Phase) (context.Context, func(error)) {
+		ctx, span := tracer.Start(ctx, "brave."+string(phase),
+			trace.WithAttributes(
+				EndpointKey.String(string(endpoint)),
+				PhaseKey.String(string(phase)),
+			),
+		)
+
+		return ctx, func(err error) {
+			if err != nil {
+				recordError(span, err)
+			}
+
+			span.End()
+		}
+	}
+}
+
+func recordError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+
+	var resp brave.ErrorResponse
+	var httpErr brave.HTTPError
+	switch {
+	case errors.As(err, &resp):
+		span.SetAttributes(ErrorCodeKey.String(resp.Code), StatusCodeKey.Int(resp.Status))
+	case errors.As(err, &httpErr):
+		span.SetAttributes(StatusCodeKey.Int(httpErr.StatusCode))
+	}
+}
+
+// resultAttributes returns the number of results in each result container,
+// whether the query was altered, and the response status.
+func resultAttributes(v any) []attribute.KeyValue {
+	var (
+		attrs []attribute.KeyValue
+		query *brave.Query
+	)
+
+	switch r := v.(type) {
+	case *brave.WebSearchResult:
+		attrs = appendCount(attrs, "discussions", r.Discussions)
+		attrs = appendCount(attrs, "faq", r.FAQ)
+		attrs = appendCount(attrs, "infobox", r.InfoBox)
+		attrs = appendCount(attrs, "locations", r.Locations)
+		attrs = appendCount(attrs, "news", r.News)
+		attrs = appendCount(attrs, "videos", r.Videos)
+		attrs = appendCount(attrs, "web", r.Web)
+		attrs = appendMeta(attrs, r.ResponseMeta())
+		query = r.Query
+	case *brave.ImageSearchResult:
+		attrs = appendCount(attrs, "images", &r.ResultContainer)
+		attrs = appendMeta(attrs, r.ResponseMeta())
+		query = r.Query
+	case *brave.VideoSearchResult:
+		attrs = appendCount(attrs, "videos", &r.ResultContainer)
+		attrs = appendMeta(attrs, r.ResponseMeta())
+		query = r.Query
+	case *brave.NewsSearchResult:
+		attrs = appendCount(attrs, "news", &r.ResultContainer)
+		attrs = appendMeta(attrs, r.ResponseMeta())
+		query = r.Query
+	case *brave.SuggestSearchResult:
+		attrs = append(attrs, countKey("suggestions").Int(len(r.Results)))
+		attrs = appendMeta(attrs, r.ResponseMeta())
+		query = r.Query
+	case *brave.SpellcheckResult:
+		attrs = append(attrs, countKey("spellcheck").Int(len(r.Results)))
+		attrs = appendMeta(attrs, r.ResponseMeta())
+		query = r.Query
+	case *brave.LocalPOIsResult:
+		attrs = append(attrs, countKey("locations").Int(len(r.Results)))
+		attrs = appendMeta(attrs, r.ResponseMeta())
+	case *brave.LocalDescriptionsResult:
+		attrs = append(attrs, countKey("descriptions").Int(len(r.Results)))
+		attrs = appendMeta(attrs, r.ResponseMeta())
+	case *brave.SummarizerSearchResult:
+		attrs = append(attrs, attribute.String("brave.summary.status", r.Status))
+		attrs = appendMeta(attrs, r.ResponseMeta())
+	}
+
+	if query != nil && query.Altered != "" {
+		attrs = append(attrs, QueryAlteredKey.String(query.Altered))
+	}
+
+	return attrs
+}
+
+func countKey(container string) attribute.Key {
+	return attribute.Key("brave.results." + container)
+}
+
+func appendCount[T any](attrs []attribute.KeyValue, container string, c *brave.ResultContainer[T]) []attribute.KeyValue {
+	if c == nil {
+		return attrs
+	}
+
+	return append(attrs, countKey(container).Int(len(c.Results)))
+}
+
+func appendMeta(attrs []attribute.KeyValue, meta *brave.ResponseMeta) []attribute.KeyValue {
+	if meta == nil {
+		return attrs
+	}
+
+	return append(attrs, StatusCodeKey.Int(meta.StatusCode), CachedKey.Bool(meta.Cached))
+}
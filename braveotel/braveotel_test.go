@@ -0,0 +1,102 @@
+package braveotel_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"dev.freespoke.com/brave-search"
+	"dev.freespoke.com/brave-search/braveotel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracing(t *testing.T) {
+	body, err := os.ReadFile("../testdata/web_0.json")
+	require.Nil(t, err)
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(body)
+	}))
+	defer svr.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		braveotel.WithTracing(braveotel.WithTracerProvider(provider)),
+	)
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "speaker of the house", brave.WithGogglesID("https://example.com/goggle"))
+	require.Nil(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 4)
+
+	names := make([]string, 0, len(spans))
+	for _, s := range spans {
+		names = append(names, s.Name())
+	}
+
+	assert.Equal(t, []string{"brave.build", "brave.round_trip", "brave.decode", "brave web/search"}, names)
+
+	root := spans[3]
+	for _, s := range spans[:3] {
+		assert.Equal(t, root.SpanContext().SpanID(), s.Parent().SpanID())
+	}
+
+	attrs := attribute.NewSet(root.Attributes()...)
+	assertAttr(t, attrs, braveotel.EndpointKey.String("web/search"))
+	assertAttr(t, attrs, braveotel.GogglesKey.Bool(true))
+	assertAttr(t, attrs, braveotel.QueryAlteredKey.String("modified"))
+	assertAttr(t, attrs, attribute.Int("brave.results.videos", 3))
+}
+
+func TestTracingError(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"type":"ErrorResponse","error":{"status":422,"code":"VALIDATION","detail":"Unable to validate request parameter(s)"}}`))
+	}))
+	defer svr.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		braveotel.WithTracing(braveotel.WithTracerProvider(provider)),
+	)
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "speaker of the house")
+	require.NotNil(t, err)
+
+	spans := recorder.Ended()
+	require.NotEmpty(t, spans)
+
+	root := spans[len(spans)-1]
+	assert.Equal(t, codes.Error, root.Status().Code)
+
+	attrs := attribute.NewSet(root.Attributes()...)
+	assertAttr(t, attrs, braveotel.ErrorCodeKey.String("VALIDATION"))
+	assertAttr(t, attrs, braveotel.StatusCodeKey.Int(422))
+}
+
+func assertAttr(t *testing.T, attrs attribute.Set, kv attribute.KeyValue) {
+	t.Helper()
+
+	v, ok := attrs.Value(kv.Key)
+	if assert.True(t, ok, kv.Key) {
+		assert.Equal(t, kv.Value, v, kv.Key)
+	}
+}
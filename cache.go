@@ -0,0 +1,185 @@
+package brave
+
+import (
+	"container/list"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultCacheTTL = 5 * time.Minute
+
+// cacheableEndpoints lists the endpoints whose results are cached by default.
+// Summaries are not cached, as they may be returned before they are complete.
+var cacheableEndpoints = []Endpoint{
+	EndpointWebSearch,
+	EndpointImageSearch,
+	EndpointVideoSearch,
+	EndpointNewsSearch,
+	EndpointLocalPOIs,
+	EndpointLocalDescriptions,
+	EndpointSuggestSearch,
+	EndpointSpellcheck,
+}
+
+// Cache stores the raw bodies of successful API responses. Implementations
+// must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored for key, if it has not expired.
+	Get(key string) ([]byte, bool)
+
+	// Set stores value for key for the duration of ttl.
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+func (b *brave) cacheTTL(endpoint Endpoint) time.Duration {
+	if b.cache == nil {
+		return 0
+	}
+
+	if ttl, ok := b.cacheTTLs[endpoint]; ok {
+		return ttl
+	}
+
+	for _, e := range cacheableEndpoints {
+		if e == endpoint {
+			return defaultCacheTTL
+		}
+	}
+
+	return 0
+}
+
+// cacheKeyHeaders lists the request headers that affect the response, in
+// addition to the URL.
+var cacheKeyHeaders = []string{
+	"Api-Version",
+	"X-Loc-City",
+	"X-Loc-Country",
+	"X-Loc-Lat",
+	"X-Loc-Long",
+	"X-Loc-Postal-Code",
+	"X-Loc-State",
+	"X-Loc-State-Name",
+	"X-Loc-Timezone",
+}
+
+// cacheKey builds a normalized key for a request from its endpoint path,
+// encoded query values and location headers. The subscription token is never
+// part of the key.
+func cacheKey(req *http.Request) string {
+	q := req.URL.Query()
+
+	var sb strings.Builder
+	sb.WriteString(req.URL.Path)
+	sb.WriteByte('?')
+	sb.WriteString(q.Encode())
+
+	headers := make([]string, 0, len(cacheKeyHeaders))
+	for _, h := range cacheKeyHeaders {
+		if v := req.Header.Get(h); v != "" {
+			headers = append(headers, h+": "+v)
+		}
+	}
+
+	sort.Strings(headers)
+	for _, h := range headers {
+		sb.WriteByte('\n')
+		sb.WriteString(h)
+	}
+
+	return sb.String()
+}
+
+// LRUCache is an in-memory [Cache] that evicts the least recently used
+// entries once it holds more than a maximum number of entries or bytes. Values
+// are copied when stored and returned, so callers may modify them freely.
+type LRUCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	maxBytes   int64
+	size       int64
+	ll         *list.List
+	items      map[string]*list.Element
+	now        func() time.Time
+}
+
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewLRUCache returns an [LRUCache] holding at most maxEntries entries and
+// maxBytes bytes of response bodies. Zero disables either bound.
+func NewLRUCache(maxEntries int, maxBytes int64) *LRUCache {
+	return &LRUCache{
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+		now:        time.Now,
+	}
+}
+
+// Get implements [Cache].
+func (c *LRUCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := el.Value.(*lruEntry)
+	if !c.now().Before(entry.expires) {
+		c.remove(el)
+		return nil, false
+	}
+
+	c.ll.MoveToFront(el)
+	return append([]byte(nil), entry.value...), true
+}
+
+// Set implements [Cache].
+func (c *LRUCache) Set(key string, value []byte, ttl time.Duration) {
+	if ttl <= 0 || (c.maxBytes > 0 && int64(len(value)) > c.maxBytes) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+
+	c.items[key] = c.ll.PushFront(&lruEntry{
+		key:     key,
+		value:   append([]byte(nil), value...),
+		expires: c.now().Add(ttl),
+	})
+	c.size += int64(len(value))
+
+	for (c.maxEntries > 0 && c.ll.Len() > c.maxEntries) || (c.maxBytes > 0 && c.size > c.maxBytes) {
+		c.remove(c.ll.Back())
+	}
+}
+
+// Len returns the number of entries in the cache, including expired entries
+// that have not been evicted yet.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.ll.Len()
+}
+
+func (c *LRUCache) remove(el *list.Element) {
+	entry := c.ll.Remove(el).(*lruEntry)
+	delete(c.items, entry.key)
+	c.size -= int64(len(entry.value))
+}
@@ -0,0 +1,120 @@
+package brave_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	body, err := os.ReadFile("testdata/web_0.json")
+	require.Nil(t, err)
+
+	var calls int32
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write(body)
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithCache(brave.NewLRUCache(10, 0)),
+	)
+	require.Nil(t, err)
+
+	ctx := context.Background()
+
+	res, err := client.WebSearch(ctx, "speaker of the house")
+	require.Nil(t, err)
+	assert.False(t, res.ResponseMeta().Cached)
+
+	res, err = client.WebSearch(ctx, "speaker of the house")
+	require.Nil(t, err)
+	assert.True(t, res.ResponseMeta().Cached)
+	assert.Equal(t, "facebook", res.Query.Original)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	_, err = client.WebSearch(ctx, "speaker of the house", brave.WithLocCity("Detroit"))
+	require.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	res, err = client.WebSearch(ctx, "speaker of the house", brave.WithNoCache(true))
+	require.Nil(t, err)
+	assert.False(t, res.ResponseMeta().Cached)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestCacheTTL(t *testing.T) {
+	var calls int32
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(`{"type":"spellcheck","results":[{"query":"house"}]}`))
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithCache(brave.NewLRUCache(10, 0)),
+		brave.WithCacheTTL(brave.EndpointSpellcheck, 0),
+	)
+	require.Nil(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = client.Spellcheck(context.Background(), "huose")
+		require.Nil(t, err)
+	}
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestLRUCache(t *testing.T) {
+	c := brave.NewLRUCache(2, 10)
+
+	c.Set("a", []byte("aaaa"), time.Minute)
+	c.Set("b", []byte("bbbb"), time.Minute)
+	_, ok := c.Get("a")
+	assert.True(t, ok)
+
+	// evicts b, the least recently used entry, to stay within 10 bytes.
+	c.Set("c", []byte("cccc"), time.Minute)
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, 2, c.Len())
+
+	// values larger than the cache are not stored.
+	c.Set("d", []byte("ddddddddddd"), time.Minute)
+	_, ok = c.Get("d")
+	assert.False(t, ok)
+
+	c.Set("e", []byte("e"), time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	_, ok = c.Get("e")
+	assert.False(t, ok)
+}
+
+func TestLRUCacheCopiesValues(t *testing.T) {
+	c := brave.NewLRUCache(0, 0)
+
+	value := []byte("aaaa")
+	c.Set("a", value, time.Minute)
+	value[0] = 'x'
+
+	got, ok := c.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, "aaaa", string(got))
+
+	got[0] = 'y'
+	got, _ = c.Get("a")
+	assert.Equal(t, "aaaa", string(got))
+}
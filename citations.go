@@ -0,0 +1,213 @@
+package brave
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CitationType identifies what a [Citation] links a span of the summary to.
+type CitationType string
+
+const (
+	// CitationTypeContext links a span to a source in
+	// [SummaryEnrichments.Context], as referenced inline by the summary.
+	CitationTypeContext CitationType = "context"
+
+	// CitationTypeEntity links a span to an entity highlighted in the summary.
+	CitationTypeEntity CitationType = "entity"
+
+	// CitationTypeAnswer links a span to an answer highlighted in the summary.
+	CitationTypeAnswer CitationType = "answer"
+)
+
+// Summary message types handled by [SummarizerSearchResult.Citations].
+const (
+	summaryMessageToken           = "token"
+	summaryMessageInlineReference = "inline_reference"
+)
+
+// Citation links a span of the text of a [CitedSummary] to its source. Exactly
+// one of the source fields is set, as indicated by Type. The fields point into
+// the [SummarizerSearchResult] the citations were resolved from.
+type Citation struct {
+	Type CitationType
+
+	// Start and End are the byte offsets of the span in [CitedSummary.Text].
+	Start int
+	End   int
+
+	// Number is the 1-based position of Context in
+	// [SummaryEnrichments.Context], for use as a footnote number. It is zero
+	// for other types of citations.
+	Number int
+
+	Context *SummaryContext
+	Entity  *SummaryEntity
+	Answer  *SummaryAnswer
+}
+
+// CitedSummary holds the plain text of a summary and the citations of its
+// spans, ordered by their position in the text.
+type CitedSummary struct {
+	Text      string
+	Citations []Citation
+}
+
+// Citations joins the token messages of the summary into plain text, and links
+// spans of the text to their sources.
+//
+// An inline reference cites the text between the previous inline reference, or
+// the start of the summary, and itself. References hold either the 1-based
+// position of a source in [SummaryEnrichments.Context] or its URL. Entity and
+// answer highlights are taken to be character offsets into the text of the
+// summary. References and highlights that cannot be resolved are ignored.
+func (r *SummarizerSearchResult) Citations() *CitedSummary {
+	var c CitedSummary
+	if r == nil {
+		return &c
+	}
+
+	// start and end delimit the span cited by the last inline reference,
+	// whether it could be resolved or not.
+	var (
+		sb         strings.Builder
+		start, end int
+		cited      bool
+	)
+
+	for _, msg := range r.Summary {
+		switch msg.Type {
+		case summaryMessageToken:
+			sb.WriteString(msg.Data)
+		case summaryMessageInlineReference:
+			// consecutive references cite the same span.
+			if sb.Len() != end || !cited {
+				start = skipSeparators(sb.String(), end)
+				end = sb.Len()
+				cited = true
+			}
+
+			n, ctx := r.resolveContext(msg.Data)
+			if ctx == nil {
+				continue
+			}
+
+			c.Citations = append(c.Citations, Citation{
+				Type:    CitationTypeContext,
+				Start:   start,
+				End:     end,
+				Number:  n,
+				Context: ctx,
+			})
+		}
+	}
+
+	c.Text = sb.String()
+
+	// byteOffsets maps character offsets to byte offsets in the text.
+	byteOffsets := make([]int, 0, len(c.Text)+1)
+	for i := range c.Text {
+		byteOffsets = append(byteOffsets, i)
+	}
+	byteOffsets = append(byteOffsets, len(c.Text))
+
+	span := func(loc TextLocation) (int, int, bool) {
+		start, end := int(loc.Start), int(loc.End)
+		if start < 0 || end <= start || end >= len(byteOffsets) {
+			return 0, 0, false
+		}
+
+		return byteOffsets[start], byteOffsets[end], true
+	}
+
+	if r.Enrichments != nil {
+		for i := range r.Enrichments.Entities {
+			entity := &r.Enrichments.Entities[i]
+			for _, loc := range entity.Highlight {
+				if start, end, ok := span(loc); ok {
+					c.Citations = append(c.Citations, Citation{Type: CitationTypeEntity, Start: start, End: end, Entity: entity})
+				}
+			}
+		}
+
+		for i := range r.Enrichments.QA {
+			answer := &r.Enrichments.QA[i]
+			if answer.Highlight == nil {
+				continue
+			}
+
+			if start, end, ok := span(*answer.Highlight); ok {
+				c.Citations = append(c.Citations, Citation{Type: CitationTypeAnswer, Start: start, End: end, Answer: answer})
+			}
+		}
+	}
+
+	sort.SliceStable(c.Citations, func(i, j int) bool {
+		if c.Citations[i].Start != c.Citations[j].Start {
+			return c.Citations[i].Start < c.Citations[j].Start
+		}
+
+		return c.Citations[i].End < c.Citations[j].End
+	})
+
+	return &c
+}
+
+// skipSeparators returns the offset of the first character of s at or after i
+// that is neither a space nor punctuation ending the previous span, such as the
+// period of the previous sentence. Opening brackets and quotes are kept.
+func skipSeparators(s string, i int) int {
+	for i < len(s) {
+		ch, size := utf8.DecodeRuneInString(s[i:])
+		if !unicode.IsSpace(ch) && !isClosingPunct(ch) {
+			break
+		}
+
+		i += size
+	}
+
+	return i
+}
+
+func isClosingPunct(ch rune) bool {
+	if ch == '"' || ch == '\'' || unicode.In(ch, unicode.Ps, unicode.Pi) {
+		return false
+	}
+
+	return unicode.IsPunct(ch)
+}
+
+// resolveContext returns the source of the summary an inline reference points
+// to, along with its 1-based position.
+func (r *SummarizerSearchResult) resolveContext(ref string) (int, *SummaryContext) {
+	if r.Enrichments == nil {
+		return 0, nil
+	}
+
+	sources := r.Enrichments.Context
+	ref = strings.TrimSpace(ref)
+
+	if n, err := strconv.Atoi(ref); err == nil {
+		if n < 1 || n > len(sources) {
+			return 0, nil
+		}
+
+		return n, &sources[n-1]
+	}
+
+	if ref == "" {
+		return 0, nil
+	}
+
+	key := NormalizeURL(ref)
+	for i := range sources {
+		if NormalizeURL(sources[i].URL) == key {
+			return i + 1, &sources[i]
+		}
+	}
+
+	return 0, nil
+}
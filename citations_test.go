@@ -0,0 +1,122 @@
+package brave_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCitations(t *testing.T) {
+	body, err := os.ReadFile("testdata/summarizer.json")
+	require.Nil(t, err)
+
+	var res brave.SummarizerSearchResult
+	require.Nil(t, json.Unmarshal(body, &res))
+
+	c := res.Citations()
+	assert.Equal(t, "The Speaker of the House is the presiding officer of the United States House of Representatives. The Speaker is elected by the members of the House.", c.Text)
+
+	type span struct {
+		Type   brave.CitationType
+		Text   string
+		Number int
+	}
+
+	var spans []span
+	for _, citation := range c.Citations {
+		spans = append(spans, span{citation.Type, c.Text[citation.Start:citation.End], citation.Number})
+	}
+
+	assert.Equal(t, []span{
+		{brave.CitationTypeContext, "The Speaker of the House is the presiding officer of the United States House of Representatives", 1},
+		{brave.CitationTypeEntity, "United States House of Representatives", 0},
+		{brave.CitationTypeContext, "The Speaker is elected by the members of the House", 2},
+		{brave.CitationTypeAnswer, "elected by the members of the House", 0},
+	}, spans)
+
+	assert.Equal(t, &res.Enrichments.Context[0], c.Citations[0].Context)
+	assert.Equal(t, "7a4bbd61-0c1c-4e71-a6c4-0ad8a4b7d2f3", c.Citations[1].Entity.UUID)
+	assert.Equal(t, &res.Enrichments.QA[0], c.Citations[3].Answer)
+}
+
+func TestCitationsReferences(t *testing.T) {
+	res := brave.SummarizerSearchResult{
+		Summary: []brave.SummaryMessage{
+			{Type: "token", Data: "Résumé first."},
+			{Type: "inline_reference", Data: "https://www.example.com/b/"},
+			{Type: "inline_reference", Data: "1"},
+			{Type: "token", Data: " Then second."},
+			{Type: "inline_reference", Data: "7"},
+		},
+		Enrichments: &brave.SummaryEnrichments{
+			Context: []brave.SummaryContext{
+				{URL: "https://example.com/a"},
+				{URL: "https://example.com/b"},
+			},
+			Entities: []brave.SummaryEntity{
+				{Name: "Résumé", Highlight: []brave.TextLocation{{Start: 0, End: 6}, {Start: 10, End: 100}}},
+			},
+		},
+	}
+
+	c := res.Citations()
+	require.Len(t, c.Citations, 3)
+
+	assert.Equal(t, brave.CitationTypeEntity, c.Citations[0].Type)
+	assert.Equal(t, "Résumé", c.Text[c.Citations[0].Start:c.Citations[0].End])
+
+	assert.Equal(t, brave.CitationTypeContext, c.Citations[1].Type)
+	assert.Equal(t, "Résumé first.", c.Text[c.Citations[1].Start:c.Citations[1].End])
+	assert.Equal(t, 2, c.Citations[1].Number)
+
+	assert.Equal(t, brave.CitationTypeContext, c.Citations[2].Type)
+	assert.Equal(t, "Résumé first.", c.Text[c.Citations[2].Start:c.Citations[2].End])
+	assert.Equal(t, 1, c.Citations[2].Number)
+}
+
+func TestCitationsSpanStart(t *testing.T) {
+	res := brave.SummarizerSearchResult{
+		Summary: []brave.SummaryMessage{
+			{Type: "token", Data: "First."},
+			{Type: "inline_reference", Data: "1"},
+			{Type: "token", Data: `), "Quoted" second.`},
+			{Type: "inline_reference", Data: "1"},
+		},
+		Enrichments: &brave.SummaryEnrichments{
+			Context: []brave.SummaryContext{{URL: "https://example.com/a"}},
+		},
+	}
+
+	c := res.Citations()
+	require.Len(t, c.Citations, 2)
+	assert.Equal(t, `"Quoted" second.`, c.Text[c.Citations[1].Start:c.Citations[1].End])
+}
+
+func TestCitationsUnresolvedReference(t *testing.T) {
+	res := brave.SummarizerSearchResult{
+		Summary: []brave.SummaryMessage{
+			{Type: "token", Data: "First."},
+			{Type: "inline_reference", Data: "1"},
+			{Type: "token", Data: " Second."},
+			{Type: "inline_reference", Data: "7"},
+			{Type: "token", Data: " Third."},
+			{Type: "inline_reference", Data: "2"},
+		},
+		Enrichments: &brave.SummaryEnrichments{
+			Context: []brave.SummaryContext{
+				{URL: "https://example.com/a"},
+				{URL: "https://example.com/b"},
+			},
+		},
+	}
+
+	c := res.Citations()
+	require.Len(t, c.Citations, 2)
+	assert.Equal(t, "First.", c.Text[c.Citations[0].Start:c.Citations[0].End])
+	assert.Equal(t, "Third.", c.Text[c.Citations[1].Start:c.Citations[1].End])
+	assert.Equal(t, 2, c.Citations[1].Number)
+}
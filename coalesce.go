@@ -0,0 +1,206 @@
+package brave
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// flightGroup de-duplicates concurrent identical requests, so that only one of
+// them is sent and all callers share its result.
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+type flight struct {
+	done    chan struct{}
+	ctx     *flightContext
+	val     any
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// do calls fn once for all concurrent callers with the same key. fn runs with a
+// context that is only cancelled once every waiting caller's context has been
+// cancelled, so a single caller giving up does not fail the others, or once
+// the latest deadline of the waiting callers has passed.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(context.Context) (any, error)) (any, error) {
+	g.mu.Lock()
+	if g.flights == nil {
+		g.flights = make(map[string]*flight)
+	}
+
+	f, ok := g.flights[key]
+	if ok && f.ctx.Err() != nil {
+		// the flight has passed its deadline, and cannot be joined.
+		ok = false
+	}
+
+	if !ok {
+		cctx, cancel := context.WithCancel(detachedContext{ctx})
+		f = &flight{done: make(chan struct{}), ctx: &flightContext{Context: cctx, cancel: cancel}, cancel: cancel}
+		g.flights[key] = f
+
+		f.ctx.join(ctx)
+		go func() {
+			f.val, f.err = fn(f.ctx)
+			f.ctx.stop()
+			cancel()
+
+			g.mu.Lock()
+			g.forget(key, f)
+			g.mu.Unlock()
+
+			close(f.done)
+		}()
+	}
+
+	if ok {
+		f.ctx.join(ctx)
+	}
+
+	f.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.val, f.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		f.waiters--
+		if f.waiters == 0 {
+			f.cancel()
+			g.forget(key, f)
+		}
+		g.mu.Unlock()
+
+		return nil, ctx.Err()
+	}
+}
+
+// forget removes f from the group, unless it has been replaced already. The
+// caller must hold g.mu.
+func (g *flightGroup) forget(key string, f *flight) {
+	if g.flights[key] == f {
+		delete(g.flights, key)
+	}
+}
+
+// detachedContext keeps the values of its parent, but is never cancelled and
+// has no deadline.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+func (d detachedContext) Value(key any) any         { return d.parent.Value(key) }
+
+// flightContext is the context of a shared request. Its deadline is extended as
+// callers join the request, so that deadline-aware retries and rate limiting
+// account for the most patient caller, and it is cancelled once its deadline
+// has passed.
+type flightContext struct {
+	context.Context
+	cancel context.CancelFunc
+
+	mu        sync.Mutex
+	deadline  time.Time
+	unbounded bool
+	timer     *time.Timer
+}
+
+// join extends the deadline of the flight to the deadline of ctx. A caller
+// without a deadline removes the deadline of the flight.
+func (c *flightContext) join(ctx context.Context) {
+	d, ok := ctx.Deadline()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch {
+	case c.unbounded:
+		return
+	case !ok:
+		c.unbounded = true
+		if c.timer != nil {
+			c.timer.Stop()
+		}
+
+		return
+	case !d.After(c.deadline):
+		return
+	}
+
+	c.deadline = d
+	if c.timer == nil {
+		c.timer = time.AfterFunc(time.Until(d), c.cancel)
+	} else {
+		c.timer.Reset(time.Until(d))
+	}
+}
+
+// stop releases the deadline timer once the request is done.
+func (c *flightContext) stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+}
+
+func (c *flightContext) Deadline() (time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.unbounded || c.deadline.IsZero() {
+		return time.Time{}, false
+	}
+
+	return c.deadline, true
+}
+
+func (c *flightContext) Err() error {
+	err := c.Context.Err()
+	if err == nil {
+		return nil
+	}
+
+	if d, ok := c.Deadline(); ok && !time.Now().Before(d) {
+		return context.DeadlineExceeded
+	}
+
+	return err
+}
+
+// requestKey identifies a request by its URL and all headers resolved from its
+// search options, except for the subscription token, which is the same for
+// every request of a client.
+func requestKey(req *http.Request) string {
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		if name != "X-Subscription-Token" {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	var sb strings.Builder
+	sb.WriteString(req.URL.String())
+	for _, name := range names {
+		sb.WriteByte('\n')
+		sb.WriteString(name)
+		sb.WriteString(": ")
+		sb.WriteString(strings.Join(req.Header[name], ", "))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,180 @@
+package brave_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getBlockingServer(t *testing.T, file string, release <-chan struct{}, calls *int32) *httptest.Server {
+	body, err := os.ReadFile(file)
+	require.Nil(t, err)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		<-release
+		_, _ = w.Write(body)
+	}))
+}
+
+func TestRequestCoalescing(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	svr := getBlockingServer(t, "testdata/web_0.json", release, &calls)
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithRequestCoalescing(true),
+	)
+	require.Nil(t, err)
+
+	const n = 10
+	results := make([]*brave.WebSearchResult, n)
+	errs := make([]error, n)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = client.WebSearch(context.Background(), "speaker of the house")
+		}(i)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	for i := 0; i < n; i++ {
+		require.Nil(t, errs[i])
+		assert.Same(t, results[0], results[i])
+	}
+}
+
+func TestRequestCoalescingCancel(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	svr := getBlockingServer(t, "testdata/web_0.json", release, &calls)
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithRequestCoalescing(true),
+	)
+	require.Nil(t, err)
+
+	var (
+		res *brave.WebSearchResult
+		wg  sync.WaitGroup
+	)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		res, err = client.WebSearch(context.Background(), "speaker of the house")
+	}()
+
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&calls) == 1 }, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, cancelledErr := client.WebSearch(ctx, "speaker of the house")
+	assert.ErrorIs(t, cancelledErr, context.DeadlineExceeded)
+
+	close(release)
+	wg.Wait()
+
+	require.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRequestCoalescingKeepsDeadline(t *testing.T) {
+	svr := getTestServer("testdata/web_0.json", 200)
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithRateLimit(1, 0),
+		brave.WithRequestCoalescing(true),
+	)
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "speaker of the house")
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = client.WebSearch(ctx, "speaker of the house")
+	assert.ErrorIs(t, err, brave.ErrRateLimited)
+}
+
+// deadlineTransport blocks until the request's context is done, and reports
+// the context's deadline and error.
+type deadlineTransport chan deadlineResult
+
+type deadlineResult struct {
+	deadline time.Time
+	err      error
+	done     time.Time
+}
+
+func (d deadlineTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	<-ctx.Done()
+
+	deadline, _ := ctx.Deadline()
+	d <- deadlineResult{deadline: deadline, err: ctx.Err(), done: time.Now()}
+	return nil, ctx.Err()
+}
+
+func TestRequestCoalescingEnforcesDeadline(t *testing.T) {
+	transport := make(deadlineTransport, 1)
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(&http.Client{Transport: transport}),
+		brave.WithBaseURL("http://127.0.0.1:0/"),
+		brave.WithRequestCoalescing(true),
+	)
+	require.Nil(t, err)
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for _, timeout := range []time.Duration{50 * time.Millisecond, 150 * time.Millisecond} {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.WebSearch(ctx, "speaker of the house")
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	wg.Wait()
+
+	res := <-transport
+	assert.ErrorIs(t, res.err, context.DeadlineExceeded)
+	assert.WithinDuration(t, start.Add(160*time.Millisecond), res.deadline, 20*time.Millisecond)
+	assert.GreaterOrEqual(t, res.done.Sub(start), 140*time.Millisecond)
+}
@@ -0,0 +1,317 @@
+package brave
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Country is a country code accepted by the `country` parameter, which
+// selects the country the search results come from.
+//
+// Refer to [Query Parameters] for more detail.
+//
+// [Query Parameters]: https://api.search.brave.com/app/documentation/query
+type Country string
+
+const (
+	CountryAll Country = "ALL" // All regions
+	CountryAR  Country = "AR"  // Argentina
+	CountryAT  Country = "AT"  // Austria
+	CountryAU  Country = "AU"  // Australia
+	CountryBE  Country = "BE"  // Belgium
+	CountryBR  Country = "BR"  // Brazil
+	CountryCA  Country = "CA"  // Canada
+	CountryCH  Country = "CH"  // Switzerland
+	CountryCL  Country = "CL"  // Chile
+	CountryCN  Country = "CN"  // China
+	CountryDE  Country = "DE"  // Germany
+	CountryDK  Country = "DK"  // Denmark
+	CountryES  Country = "ES"  // Spain
+	CountryFI  Country = "FI"  // Finland
+	CountryFR  Country = "FR"  // France
+	CountryGB  Country = "GB"  // United Kingdom
+	CountryHK  Country = "HK"  // Hong Kong
+	CountryID  Country = "ID"  // Indonesia
+	CountryIN  Country = "IN"  // India
+	CountryIT  Country = "IT"  // Italy
+	CountryJP  Country = "JP"  // Japan
+	CountryKR  Country = "KR"  // Korea
+	CountryMX  Country = "MX"  // Mexico
+	CountryMY  Country = "MY"  // Malaysia
+	CountryNL  Country = "NL"  // Netherlands
+	CountryNO  Country = "NO"  // Norway
+	CountryNZ  Country = "NZ"  // New Zealand
+	CountryPH  Country = "PH"  // Philippines
+	CountryPL  Country = "PL"  // Poland
+	CountryPT  Country = "PT"  // Portugal
+	CountryRU  Country = "RU"  // Russia
+	CountrySA  Country = "SA"  // Saudi Arabia
+	CountrySE  Country = "SE"  // Sweden
+	CountryTR  Country = "TR"  // Turkey
+	CountryTW  Country = "TW"  // Taiwan
+	CountryUS  Country = "US"  // United States
+	CountryZA  Country = "ZA"  // South Africa
+)
+
+// SearchLang is a language code accepted by the `search_lang` parameter, which
+// selects the language of the search results.
+//
+// Refer to [Query Parameters] for more detail.
+//
+// [Query Parameters]: https://api.search.brave.com/app/documentation/query
+type SearchLang string
+
+const (
+	SearchLangAR     SearchLang = "ar"      // Arabic
+	SearchLangBG     SearchLang = "bg"      // Bulgarian
+	SearchLangBN     SearchLang = "bn"      // Bengali
+	SearchLangCA     SearchLang = "ca"      // Catalan
+	SearchLangCS     SearchLang = "cs"      // Czech
+	SearchLangDA     SearchLang = "da"      // Danish
+	SearchLangDE     SearchLang = "de"      // German
+	SearchLangEN     SearchLang = "en"      // English
+	SearchLangENGB   SearchLang = "en-gb"   // British English
+	SearchLangES     SearchLang = "es"      // Spanish
+	SearchLangET     SearchLang = "et"      // Estonian
+	SearchLangEU     SearchLang = "eu"      // Basque
+	SearchLangFI     SearchLang = "fi"      // Finnish
+	SearchLangFR     SearchLang = "fr"      // French
+	SearchLangGL     SearchLang = "gl"      // Galician
+	SearchLangGU     SearchLang = "gu"      // Gujarati
+	SearchLangHE     SearchLang = "he"      // Hebrew
+	SearchLangHI     SearchLang = "hi"      // Hindi
+	SearchLangHR     SearchLang = "hr"      // Croatian
+	SearchLangHU     SearchLang = "hu"      // Hungarian
+	SearchLangIS     SearchLang = "is"      // Icelandic
+	SearchLangIT     SearchLang = "it"      // Italian
+	SearchLangJP     SearchLang = "jp"      // Japanese
+	SearchLangKN     SearchLang = "kn"      // Kannada
+	SearchLangKO     SearchLang = "ko"      // Korean
+	SearchLangLT     SearchLang = "lt"      // Lithuanian
+	SearchLangLV     SearchLang = "lv"      // Latvian
+	SearchLangML     SearchLang = "ml"      // Malayalam
+	SearchLangMR     SearchLang = "mr"      // Marathi
+	SearchLangMS     SearchLang = "ms"      // Malay
+	SearchLangNB     SearchLang = "nb"      // Norwegian Bokmål
+	SearchLangNL     SearchLang = "nl"      // Dutch
+	SearchLangPA     SearchLang = "pa"      // Punjabi
+	SearchLangPL     SearchLang = "pl"      // Polish
+	SearchLangPTBR   SearchLang = "pt-br"   // Brazilian Portuguese
+	SearchLangPTPT   SearchLang = "pt-pt"   // European Portuguese
+	SearchLangRO     SearchLang = "ro"      // Romanian
+	SearchLangRU     SearchLang = "ru"      // Russian
+	SearchLangSK     SearchLang = "sk"      // Slovak
+	SearchLangSL     SearchLang = "sl"      // Slovenian
+	SearchLangSR     SearchLang = "sr"      // Serbian
+	SearchLangSV     SearchLang = "sv"      // Swedish
+	SearchLangTA     SearchLang = "ta"      // Tamil
+	SearchLangTE     SearchLang = "te"      // Telugu
+	SearchLangTH     SearchLang = "th"      // Thai
+	SearchLangTR     SearchLang = "tr"      // Turkish
+	SearchLangUK     SearchLang = "uk"      // Ukrainian
+	SearchLangVI     SearchLang = "vi"      // Vietnamese
+	SearchLangZHHans SearchLang = "zh-hans" // Simplified Chinese
+	SearchLangZHHant SearchLang = "zh-hant" // Traditional Chinese
+)
+
+// UILang is a locale accepted by the `ui_lang` parameter, which selects the
+// language and region of the user interface strings in the response.
+//
+// Refer to [Query Parameters] for more detail.
+//
+// [Query Parameters]: https://api.search.brave.com/app/documentation/query
+type UILang string
+
+const (
+	UILangDADK UILang = "da-DK"
+	UILangDEAT UILang = "de-AT"
+	UILangDECH UILang = "de-CH"
+	UILangDEDE UILang = "de-DE"
+	UILangENAU UILang = "en-AU"
+	UILangENCA UILang = "en-CA"
+	UILangENGB UILang = "en-GB"
+	UILangENID UILang = "en-ID"
+	UILangENIN UILang = "en-IN"
+	UILangENMY UILang = "en-MY"
+	UILangENNZ UILang = "en-NZ"
+	UILangENPH UILang = "en-PH"
+	UILangENUS UILang = "en-US"
+	UILangENZA UILang = "en-ZA"
+	UILangESAR UILang = "es-AR"
+	UILangESCL UILang = "es-CL"
+	UILangESES UILang = "es-ES"
+	UILangESMX UILang = "es-MX"
+	UILangESUS UILang = "es-US"
+	UILangFIFI UILang = "fi-FI"
+	UILangFRBE UILang = "fr-BE"
+	UILangFRCA UILang = "fr-CA"
+	UILangFRCH UILang = "fr-CH"
+	UILangFRFR UILang = "fr-FR"
+	UILangITIT UILang = "it-IT"
+	UILangJAJP UILang = "ja-JP"
+	UILangKOKR UILang = "ko-KR"
+	UILangNLBE UILang = "nl-BE"
+	UILangNLNL UILang = "nl-NL"
+	UILangNONO UILang = "no-NO"
+	UILangPLPL UILang = "pl-PL"
+	UILangPTBR UILang = "pt-BR"
+	UILangRURU UILang = "ru-RU"
+	UILangSVSE UILang = "sv-SE"
+	UILangTRTR UILang = "tr-TR"
+	UILangZHCN UILang = "zh-CN"
+	UILangZHHK UILang = "zh-HK"
+	UILangZHTW UILang = "zh-TW"
+)
+
+// countries lists every [Country] supported by the API.
+var countries = []Country{
+	CountryAll, CountryAR, CountryAT, CountryAU, CountryBE, CountryBR, CountryCA, CountryCH, CountryCL, CountryCN,
+	CountryDE, CountryDK, CountryES, CountryFI, CountryFR, CountryGB, CountryHK, CountryID, CountryIN, CountryIT,
+	CountryJP, CountryKR, CountryMX, CountryMY, CountryNL, CountryNO, CountryNZ, CountryPH, CountryPL, CountryPT,
+	CountryRU, CountrySA, CountrySE, CountryTR, CountryTW, CountryUS, CountryZA,
+}
+
+// searchLangs lists every [SearchLang] supported by the API.
+var searchLangs = []SearchLang{
+	SearchLangAR, SearchLangBG, SearchLangBN, SearchLangCA, SearchLangCS, SearchLangDA, SearchLangDE,
+	SearchLangEN, SearchLangENGB, SearchLangES, SearchLangET, SearchLangEU, SearchLangFI, SearchLangFR,
+	SearchLangGL, SearchLangGU, SearchLangHE, SearchLangHI, SearchLangHR, SearchLangHU, SearchLangIS,
+	SearchLangIT, SearchLangJP, SearchLangKN, SearchLangKO, SearchLangLT, SearchLangLV, SearchLangML,
+	SearchLangMR, SearchLangMS, SearchLangNB, SearchLangNL, SearchLangPA, SearchLangPL, SearchLangPTBR,
+	SearchLangPTPT, SearchLangRO, SearchLangRU, SearchLangSK, SearchLangSL, SearchLangSR, SearchLangSV,
+	SearchLangTA, SearchLangTE, SearchLangTH, SearchLangTR, SearchLangUK, SearchLangVI, SearchLangZHHans,
+	SearchLangZHHant,
+}
+
+// uiLangs lists every [UILang] supported by the API.
+var uiLangs = []UILang{
+	UILangDADK, UILangDEAT, UILangDECH, UILangDEDE, UILangENAU, UILangENCA, UILangENGB, UILangENID, UILangENIN,
+	UILangENMY, UILangENNZ, UILangENPH, UILangENUS, UILangENZA, UILangESAR, UILangESCL, UILangESES, UILangESMX,
+	UILangESUS, UILangFIFI, UILangFRBE, UILangFRCA, UILangFRCH, UILangFRFR, UILangITIT, UILangJAJP, UILangKOKR,
+	UILangNLBE, UILangNLNL, UILangNONO, UILangPLPL, UILangPTBR, UILangRURU, UILangSVSE, UILangTRTR, UILangZHCN,
+	UILangZHHK, UILangZHTW,
+}
+
+// countryAliases maps common alternatives to the codes used by the API.
+var countryAliases = map[string]Country{
+	"UK":  CountryGB,
+	"USA": CountryUS,
+	"ANY": CountryAll,
+}
+
+// searchLangAliases maps common alternatives to the codes used by the API,
+// after normalization to lower case with hyphens.
+var searchLangAliases = map[string]SearchLang{
+	"en-uk": SearchLangENGB,
+	"iw":    SearchLangHE,
+	"ja":    SearchLangJP,
+	"no":    SearchLangNB,
+	"nn":    SearchLangNB,
+	"pt":    SearchLangPTPT,
+	"zh":    SearchLangZHHans,
+	"zh-cn": SearchLangZHHans,
+	"zh-sg": SearchLangZHHans,
+	"zh-hk": SearchLangZHHant,
+	"zh-mo": SearchLangZHHant,
+	"zh-tw": SearchLangZHHant,
+}
+
+// uiLangAliases maps common alternatives to the locales used by the API, after
+// normalization to lower case with hyphens. Bare languages map to the locale
+// of the country most associated with them.
+var uiLangAliases = map[string]UILang{
+	"da":    UILangDADK,
+	"de":    UILangDEDE,
+	"en":    UILangENUS,
+	"en-uk": UILangENGB,
+	"es":    UILangESES,
+	"fi":    UILangFIFI,
+	"fr":    UILangFRFR,
+	"it":    UILangITIT,
+	"ja":    UILangJAJP,
+	"jp":    UILangJAJP,
+	"ko":    UILangKOKR,
+	"nb":    UILangNONO,
+	"nb-no": UILangNONO,
+	"nl":    UILangNLNL,
+	"no":    UILangNONO,
+	"pl":    UILangPLPL,
+	"pt":    UILangPTBR,
+	"ru":    UILangRURU,
+	"sv":    UILangSVSE,
+	"tr":    UILangTRTR,
+	"zh":    UILangZHCN,
+}
+
+// ParseCountry returns the [Country] for s, ignoring case. It accepts the
+// aliases `UK`, `USA` and `ANY`.
+func ParseCountry(s string) (Country, error) {
+	code := strings.ToUpper(strings.TrimSpace(s))
+
+	for _, c := range countries {
+		if string(c) == code {
+			return c, nil
+		}
+	}
+
+	if c, ok := countryAliases[code]; ok {
+		return c, nil
+	}
+
+	return "", fmt.Errorf("%w: unsupported country %q", ErrValidation, s)
+}
+
+// ParseSearchLang returns the [SearchLang] for s, ignoring case and accepting
+// underscores in place of hyphens. Common aliases such as `ja` and `zh-TW` are
+// accepted, and regional variants that the API does not distinguish, such as
+// `en-US` or `fr-CA`, map to their language.
+func ParseSearchLang(s string) (SearchLang, error) {
+	code := normalizeLangTag(s)
+
+	for _, l := range searchLangs {
+		if string(l) == code {
+			return l, nil
+		}
+	}
+
+	if l, ok := searchLangAliases[code]; ok {
+		return l, nil
+	}
+
+	if lang, _, ok := strings.Cut(code, "-"); ok {
+		for _, l := range searchLangs {
+			if string(l) == lang {
+				return l, nil
+			}
+		}
+
+		if l, ok := searchLangAliases[lang]; ok {
+			return l, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: unsupported search language %q", ErrValidation, s)
+}
+
+// ParseUILang returns the [UILang] for s, ignoring case and accepting
+// underscores in place of hyphens. Bare languages such as `en` map to a
+// default locale, such as `en-US`.
+func ParseUILang(s string) (UILang, error) {
+	code := normalizeLangTag(s)
+
+	for _, l := range uiLangs {
+		if strings.ToLower(string(l)) == code {
+			return l, nil
+		}
+	}
+
+	if l, ok := uiLangAliases[code]; ok {
+		return l, nil
+	}
+
+	return "", fmt.Errorf("%w: unsupported UI language %q", ErrValidation, s)
+}
+
+func normalizeLangTag(s string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(s), "_", "-"))
+}
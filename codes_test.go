@@ -0,0 +1,88 @@
+package brave_test
+
+import (
+	"context"
+	"testing"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCountry(t *testing.T) {
+	tests := map[string]brave.Country{
+		"us":  brave.CountryUS,
+		"GB":  brave.CountryGB,
+		"uk":  brave.CountryGB,
+		"all": brave.CountryAll,
+	}
+
+	for in, want := range tests {
+		got, err := brave.ParseCountry(in)
+		assert.Nil(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+
+	_, err := brave.ParseCountry("XX")
+	assert.ErrorIs(t, err, brave.ErrValidation)
+}
+
+func TestParseSearchLang(t *testing.T) {
+	tests := map[string]brave.SearchLang{
+		"en":      brave.SearchLangEN,
+		"en-US":   brave.SearchLangEN,
+		"en_GB":   brave.SearchLangENGB,
+		"fr-CA":   brave.SearchLangFR,
+		"ja":      brave.SearchLangJP,
+		"pt-BR":   brave.SearchLangPTBR,
+		"pt":      brave.SearchLangPTPT,
+		"zh-TW":   brave.SearchLangZHHant,
+		"zh-Hans": brave.SearchLangZHHans,
+	}
+
+	for in, want := range tests {
+		got, err := brave.ParseSearchLang(in)
+		assert.Nil(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+
+	_, err := brave.ParseSearchLang("xx")
+	assert.ErrorIs(t, err, brave.ErrValidation)
+}
+
+func TestParseUILang(t *testing.T) {
+	tests := map[string]brave.UILang{
+		"en-US": brave.UILangENUS,
+		"en_us": brave.UILangENUS,
+		"en":    brave.UILangENUS,
+		"en-UK": brave.UILangENGB,
+		"ja":    brave.UILangJAJP,
+		"fr-ca": brave.UILangFRCA,
+	}
+
+	for in, want := range tests {
+		got, err := brave.ParseUILang(in)
+		assert.Nil(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+
+	_, err := brave.ParseUILang("en-FR")
+	assert.ErrorIs(t, err, brave.ErrValidation)
+}
+
+func TestCodeOptions(t *testing.T) {
+	var rawQuery string
+	svr := getQueryServer(t, "", &rawQuery)
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "cats",
+		brave.WithCountryCode(brave.CountryGB),
+		brave.WithLangCode(brave.SearchLangENGB),
+		brave.WithUILangCode(brave.UILangENGB),
+	)
+	require.Nil(t, err)
+	assert.Equal(t, "country=GB&q=cats&safesearch=moderate&search_lang=en-gb&ui_lang=en-GB", rawQuery)
+}
@@ -0,0 +1,256 @@
+package brave
+
+import (
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+)
+
+// trackingParams lists query parameters that do not affect the content of a
+// page and are removed by [NormalizeURL].
+var trackingParams = map[string]bool{
+	"dclid":   true,
+	"fbclid":  true,
+	"gclid":   true,
+	"igshid":  true,
+	"mc_cid":  true,
+	"mc_eid":  true,
+	"msclkid": true,
+	"ref_src": true,
+	"yclid":   true,
+	"amp":     true,
+}
+
+const ampCacheSuffix = ".cdn.ampproject.org"
+
+// taxonomySegments lists path segments under which a trailing `amp` segment
+// names a tag or topic, such as `/tags/amp`, rather than an AMP variant.
+var taxonomySegments = map[string]bool{
+	"categories": true,
+	"category":   true,
+	"tag":        true,
+	"tags":       true,
+	"topic":      true,
+	"topics":     true,
+}
+
+// NormalizeURL returns a key identifying the page at raw, so that variants of
+// the same URL yield the same key. It ignores the scheme, a `www.` or `amp.`
+// host prefix, trailing slashes, fragments, tracking parameters such as
+// `utm_source`, and AMP variants of the page marked by a trailing `/amp`
+// segment, an `.amp` extension or an `amp` query parameter, including pages
+// served from the Google AMP cache. A path of `/amp` alone, or a tag or topic
+// named `amp` such as `/tags/amp`, is not an AMP variant. Query parameters are
+// sorted.
+//
+// URLs that cannot be parsed are returned unchanged.
+func NormalizeURL(raw string) string {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" {
+		return raw
+	}
+
+	host := strings.ToLower(u.Hostname())
+	p := u.EscapedPath()
+
+	// https://example-com.cdn.ampproject.org/c/s/example.com/article
+	if strings.HasSuffix(host, ampCacheSuffix) {
+		for _, prefix := range []string{"/c/s/", "/v/s/", "/c/", "/v/"} {
+			if rest, ok := strings.CutPrefix(p, prefix); ok {
+				host, p, _ = strings.Cut(rest, "/")
+				host = strings.ToLower(host)
+				p = "/" + p
+				break
+			}
+		}
+	}
+
+	host = trimHostPrefix(host, "www.")
+	host = trimHostPrefix(host, "amp.")
+
+	if port := u.Port(); port != "" && port != "80" && port != "443" {
+		host += ":" + port
+	}
+
+	p = strings.TrimRight(p, "/")
+	if dir, base := path.Split(p); base == "amp" {
+		if parent := path.Base(dir); dir != "/" && !taxonomySegments[strings.ToLower(parent)] {
+			p = strings.TrimRight(dir, "/")
+		}
+	} else {
+		p = strings.TrimSuffix(p, ".amp")
+	}
+
+	q := u.Query()
+	for k, v := range q {
+		lk := strings.ToLower(k)
+		if trackingParams[lk] || strings.HasPrefix(lk, "utm_") || (lk == "outputtype" && len(v) == 1 && v[0] == "amp") {
+			q.Del(k)
+		}
+	}
+
+	key := host + p
+	if len(q) > 0 {
+		keys := make([]string, 0, len(q))
+		for k := range q {
+			keys = append(keys, k)
+		}
+
+		sort.Strings(keys)
+
+		params := make([]string, 0, len(keys))
+		for _, k := range keys {
+			for _, v := range q[k] {
+				params = append(params, url.QueryEscape(k)+"="+url.QueryEscape(v))
+			}
+		}
+
+		key += "?" + strings.Join(params, "&")
+	}
+
+	return key
+}
+
+// trimHostPrefix removes prefix from host, unless that would leave a host with
+// a single label, such as `amp.dev`.
+func trimHostPrefix(host, prefix string) string {
+	if rest, ok := strings.CutPrefix(host, prefix); ok && strings.Contains(rest, ".") {
+		return rest
+	}
+
+	return host
+}
+
+// MergeWebSearchResults merges consecutive pages of web search results into a
+// single result, removing duplicate results by their normalized URL (see
+// [NormalizeURL]).
+//
+// Pages must be given in order. Of duplicate results, the highest-ranked
+// occurrence is kept: results on earlier pages rank higher, and within a page,
+// web results rank above news, videos and discussions, in that order, and
+// cluster entries of web results rank below all of them. Cluster entries that
+// duplicate a higher-ranked result are removed as well.
+//
+// The query, FAQ, infobox, locations and summarizer of the merged result are
+// taken from the first page that has them. Mixed is always nil, since the
+// references of the individual pages no longer apply.
+func MergeWebSearchResults(pages ...*WebSearchResult) *WebSearchResult {
+	var (
+		merged WebSearchResult
+		seen   = make(map[string]bool)
+	)
+
+	for _, page := range pages {
+		if page == nil {
+			continue
+		}
+
+		if merged.Type == "" {
+			merged.Type = page.Type
+		}
+
+		if merged.Query == nil {
+			merged.Query = page.Query
+		}
+
+		if merged.FAQ == nil {
+			merged.FAQ = page.FAQ
+		}
+
+		if merged.InfoBox == nil {
+			merged.InfoBox = page.InfoBox
+		}
+
+		if merged.Locations == nil {
+			merged.Locations = page.Locations
+		}
+
+		if merged.Summarizer == nil {
+			merged.Summarizer = page.Summarizer
+		}
+
+		// first is the index of the first web result of this page.
+		first := 0
+		if merged.Web != nil {
+			first = len(merged.Web.Results)
+		}
+
+		merged.Web = mergeContainer(merged.Web, page.Web, seen, func(r SearchResult) string {
+			return r.URL
+		})
+
+		merged.News = mergeContainer(merged.News, page.News, seen, func(r NewsResult) string {
+			return r.URL
+		})
+
+		merged.Videos = mergeContainer(merged.Videos, page.Videos, seen, func(r VideoResult) string {
+			return r.URL
+		})
+
+		merged.Discussions = mergeContainer(merged.Discussions, page.Discussions, seen, func(r DiscussionResult) string {
+			return r.URL
+		})
+
+		if merged.Web != nil {
+			for i := first; i < len(merged.Web.Results); i++ {
+				merged.Web.Results[i].Cluster = dedupeCluster(merged.Web.Results[i].Cluster, seen)
+			}
+		}
+	}
+
+	return &merged
+}
+
+// mergeContainer appends the results of src that have not been seen yet to
+// dst, which is allocated if nil.
+func mergeContainer[T any](dst, src *ResultContainer[T], seen map[string]bool, urlOf func(T) string) *ResultContainer[T] {
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		dst = &ResultContainer[T]{Type: src.Type}
+	}
+
+	dst.MutatedByGoggles = dst.MutatedByGoggles || src.MutatedByGoggles
+
+	for _, r := range src.Results {
+		if raw := urlOf(r); raw != "" {
+			key := NormalizeURL(raw)
+			if seen[key] {
+				continue
+			}
+
+			seen[key] = true
+		}
+
+		dst.Results = append(dst.Results, r)
+	}
+
+	return dst
+}
+
+// dedupeCluster removes cluster entries that duplicate any top-level result or
+// an earlier cluster entry.
+func dedupeCluster(cluster []Result, seen map[string]bool) []Result {
+	if len(cluster) == 0 {
+		return cluster
+	}
+
+	out := make([]Result, 0, len(cluster))
+	for _, r := range cluster {
+		if r.URL != "" {
+			key := NormalizeURL(r.URL)
+			if seen[key] {
+				continue
+			}
+
+			seen[key] = true
+		}
+
+		out = append(out, r)
+	}
+
+	return out
+}
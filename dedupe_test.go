@@ -0,0 +1,92 @@
+package brave_test
+
+import (
+	"testing"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeURL(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{"https://www.example.com/article/", "example.com/article"},
+		{"http://example.com/article?utm_source=x&fbclid=y", "example.com/article"},
+		{"https://example.com/article.amp", "example.com/article"},
+		{"https://example.com/article/amp", "example.com/article"},
+		{"https://example.com/2024/05/some-story/amp/", "example.com/2024/05/some-story"},
+		{"https://amp.example.com/article", "example.com/article"},
+		{"https://amp.dev/documentation", "amp.dev/documentation"},
+		{"https://example.com/amp", "example.com/amp"},
+		{"https://example.com/tags/amp/", "example.com/tags/amp"},
+		{"https://example.com/article?outputType=amp", "example.com/article"},
+		{"https://example-com.cdn.ampproject.org/c/s/example.com/article", "example.com/article"},
+		{"https://example.com/search?b=2&a=1#top", "example.com/search?a=1&b=2"},
+		{"https://example.com:8080/", "example.com:8080"},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expected, brave.NormalizeURL(c.input), c.input)
+	}
+}
+
+func TestMergeWebSearchResults(t *testing.T) {
+	page := func(web []string, news []string) *brave.WebSearchResult {
+		res := &brave.WebSearchResult{
+			Query: &brave.Query{Original: "q"},
+			Web:   &brave.ResultContainer[brave.SearchResult]{Type: "search"},
+			News:  &brave.ResultContainer[brave.NewsResult]{Type: "news"},
+			Mixed: &brave.Mixed{},
+		}
+
+		for _, u := range web {
+			var r brave.SearchResult
+			r.URL = u
+			res.Web.Results = append(res.Web.Results, r)
+		}
+
+		for _, u := range news {
+			var r brave.NewsResult
+			r.URL = u
+			res.News.Results = append(res.News.Results, r)
+		}
+
+		return res
+	}
+
+	first := page(
+		[]string{"https://a.com/1", "https://b.com/2/"},
+		[]string{"https://a.com/1?utm_source=news", "https://c.com/3"},
+	)
+	first.Web.Results[0].Cluster = []brave.Result{{URL: "https://www.b.com/2"}, {URL: "https://a.com/4"}}
+
+	second := page(
+		[]string{"http://b.com/2", "https://d.com/5", "https://a.com/4"},
+		[]string{"https://c.com/3?outputType=amp"},
+	)
+	second.Web.Results[1].Cluster = []brave.Result{{URL: "https://c.com/3"}, {URL: "https://e.com/6"}}
+
+	merged := brave.MergeWebSearchResults(first, second)
+	require.NotNil(t, merged.Web)
+	require.NotNil(t, merged.News)
+
+	var web, news []string
+	for _, r := range merged.Web.Results {
+		web = append(web, r.URL)
+	}
+
+	for _, r := range merged.News.Results {
+		news = append(news, r.URL)
+	}
+
+	assert.Equal(t, []string{"https://a.com/1", "https://b.com/2/", "https://d.com/5"}, web)
+	assert.Equal(t, []string{"https://c.com/3"}, news)
+	assert.Equal(t, []brave.Result{{URL: "https://a.com/4"}}, merged.Web.Results[0].Cluster)
+	assert.Equal(t, []brave.Result{{URL: "https://e.com/6"}}, merged.Web.Results[2].Cluster)
+	assert.Same(t, first.Query, merged.Query)
+	assert.Nil(t, merged.Mixed)
+	assert.Len(t, first.Web.Results[0].Cluster, 2)
+}
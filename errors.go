@@ -0,0 +1,200 @@
+package brave
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// maxErrorBodySize limits how much of an error response body is read.
+	maxErrorBodySize = 64 << 10
+
+	// maxErrorSnippetSize limits the body snippet kept in [HTTPError].
+	maxErrorSnippetSize = 512
+)
+
+// Error classes returned by the client. Errors returned by the client, such
+// as [ErrorResponse], match one of these with [errors.Is].
+var (
+	// ErrUnauthorized indicates that the subscription token is missing,
+	// invalid, or not allowed to use the requested endpoint.
+	ErrUnauthorized = errors.New("brave: unauthorized")
+
+	// ErrRateLimited indicates that the per-second rate limit was exceeded,
+	// either by the API or by the client's own rate limiter.
+	ErrRateLimited = errors.New("brave: rate limit exceeded")
+
+	// ErrQuotaExceeded indicates that the monthly request quota has been used
+	// up.
+	ErrQuotaExceeded = errors.New("brave: monthly quota exceeded")
+
+	// ErrValidation indicates that the API rejected the request parameters.
+	ErrValidation = errors.New("brave: invalid request")
+
+	// ErrUpstream indicates a server-side failure of the API.
+	ErrUpstream = errors.New("brave: upstream error")
+
+	// ErrUnsupportedOption indicates that a search option was passed to an
+	// endpoint that does not support it. See [WithStrictOptions].
+	ErrUnsupportedOption = errors.New("brave: unsupported option")
+
+	// ErrSummaryFailed indicates that the summarizer reported a failed
+	// summary.
+	ErrSummaryFailed = errors.New("brave: summary failed")
+
+	// ErrSummaryPending indicates that the summary was still being generated
+	// when [Brave.WaitForSummary] ran out of polls.
+	ErrSummaryPending = errors.New("brave: summary pending")
+)
+
+// Error codes returned by the API in [ErrorResponse.Code].
+const (
+	codeQuotaLimited  = "QUOTA_LIMITED"
+	codeRateLimited   = "RATE_LIMITED"
+	codeTokenInvalid  = "SUBSCRIPTION_TOKEN_INVALID"
+	codeOptionInvalid = "OPTION_NOT_IN_PLAN"
+	codeValidation    = "VALIDATION"
+)
+
+// IsRetryable reports whether the request that failed with err may succeed if
+// it is sent again: rate-limited requests, server-side failures, and requests
+// that failed before a response was received. Exhausted quotas, validation
+// errors and cancelled contexts are not retryable.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return false
+	case errors.Is(err, ErrRateLimited), errors.Is(err, ErrUpstream):
+		return true
+	}
+
+	var ue *url.Error
+	return errors.As(err, &ue)
+}
+
+// statusClass returns the error class of an HTTP status code, or nil if the
+// status is not an error.
+func statusClass(status int) error {
+	switch {
+	case status == http.StatusUnauthorized, status == http.StatusForbidden:
+		return ErrUnauthorized
+	case status == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case status == http.StatusBadRequest, status == http.StatusUnprocessableEntity:
+		return ErrValidation
+	case status >= http.StatusInternalServerError:
+		return ErrUpstream
+	default:
+		return nil
+	}
+}
+
+// Is reports whether the error belongs to the given error class, such as
+// [ErrRateLimited].
+func (er ErrorResponse) Is(target error) bool {
+	class := er.Unwrap()
+	return class != nil && class == target
+}
+
+// Unwrap returns the error class of the response, such as [ErrValidation], or
+// nil if it is not known.
+func (er ErrorResponse) Unwrap() error {
+	switch er.Code {
+	case codeQuotaLimited:
+		return ErrQuotaExceeded
+	case codeRateLimited:
+		return ErrRateLimited
+	case codeTokenInvalid, codeOptionInvalid:
+		return ErrUnauthorized
+	case codeValidation:
+		return ErrValidation
+	default:
+		return statusClass(er.Status)
+	}
+}
+
+// HTTPError is returned when the API responds with a non-200 status and a body
+// that is not the documented error envelope, for example an HTML error page
+// from an intermediate proxy or an empty body.
+type HTTPError struct {
+	StatusCode  int
+	ContentType string
+
+	// Body holds the beginning of the response body, truncated to a few
+	// hundred bytes.
+	Body string
+
+	RawQuery string
+}
+
+func newHTTPError(req *http.Request, res *http.Response, body []byte) HTTPError {
+	truncated := len(body) > maxErrorSnippetSize
+	if truncated {
+		body = body[:maxErrorSnippetSize]
+	}
+
+	snippet := strings.ToValidUTF8(string(body), "")
+	if truncated {
+		snippet += "..."
+	}
+
+	return HTTPError{
+		StatusCode:  res.StatusCode,
+		ContentType: res.Header.Get("Content-Type"),
+		Body:        snippet,
+		RawQuery:    req.URL.RawQuery,
+	}
+}
+
+func (e HTTPError) Error() string {
+	msg := fmt.Sprintf("unexpected response: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if e.ContentType != "" {
+		msg += " (" + e.ContentType + ")"
+	}
+
+	if body := strings.TrimSpace(e.Body); body != "" {
+		msg += ": " + body
+	}
+
+	return msg
+}
+
+// Is reports whether the error belongs to the given error class, such as
+// [ErrUpstream].
+func (e HTTPError) Is(target error) bool {
+	class := e.Unwrap()
+	return class != nil && class == target
+}
+
+// Unwrap returns the error class of the status code, such as [ErrUpstream], or
+// nil if it is not known.
+func (e HTTPError) Unwrap() error {
+	return statusClass(e.StatusCode)
+}
+
+// UnsupportedOptionError is returned when search options are passed to an
+// endpoint that does not support them and [WithStrictOptions] is enabled.
+type UnsupportedOptionError struct {
+	Endpoint Endpoint
+
+	// Options holds the names of the unsupported options, such as
+	// `WithRich`.
+	Options []string
+}
+
+func (e UnsupportedOptionError) Error() string {
+	return fmt.Sprintf("brave: %s does not support %s", e.Endpoint, strings.Join(e.Options, ", "))
+}
+
+// Is reports whether target is [ErrUnsupportedOption].
+func (e UnsupportedOptionError) Is(target error) bool {
+	return target == ErrUnsupportedOption
+}
@@ -1,6 +1,6 @@
 module dev.freespoke.com/brave-search
 
-go 1.20
+go 1.21
 
 require (
 	github.com/google/go-querystring v1.1.0
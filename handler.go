@@ -1,37 +1,213 @@
 package brave
 
 import (
+	"context"
 	"encoding/json"
+	"io"
 	"net/http"
+	"time"
+
+	"github.com/google/go-querystring/query"
 )
 
-func handleRequest[T any](client *http.Client, req *http.Request) (*T, error) {
-	res, err := client.Do(req)
+func search[T any](ctx context.Context, b *brave, endpoint Endpoint, term string, params any, opts searchOptions) (*T, error) {
+	if err := b.checkOptions(ctx, endpoint, opts); err != nil {
+		return nil, err
+	}
+
+	values, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	defer res.Body.Close()
+	if !b.skipValidation {
+		if err := validateOptions(endpoint, opts, values.Encode()); err != nil {
+			return nil, err
+		}
+	}
 
-	if res.StatusCode != http.StatusOK {
-		var resp errorResponse
-		if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+	header := make(http.Header)
+	opts.applyRequestHeaders(header)
+
+	r := &Request{
+		Endpoint: endpoint,
+		Term:     term,
+		Params:   values,
+		Header:   header,
+	}
+
+	v, err := b.chain(send[T](b))(ctx, r)
+	return handlerResult[T](endpoint, v, err)
+}
+
+// send returns the innermost [Handler], which sends the request to the API.
+func send[T any](b *brave) Handler {
+	return func(ctx context.Context, r *Request) (any, error) {
+		req, err := b.newRequest(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := handleRequest[T](b, r.Endpoint, req)
+		if err != nil {
 			return nil, err
 		}
 
-		resp.Error.Time = resp.Time
-		resp.Error.RawQuery = req.URL.RawQuery
-		return nil, resp.Error
+		return res, nil
+	}
+}
+
+func (b *brave) newRequest(ctx context.Context, r *Request) (_ *http.Request, err error) {
+	_, end := b.startPhase(ctx, r.Endpoint, PhaseBuild)
+	defer func() { end(err) }()
+
+	u := *b.baseURL
+	u.Path = u.Path + string(r.Endpoint)
+	u.RawQuery = r.Params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header = r.Header.Clone()
+	if req.Header == nil {
+		req.Header = make(http.Header)
+	}
+
+	req.Header.Set("X-Subscription-Token", b.subscriptionToken)
+	return req, nil
+}
+
+func handleRequest[T any](b *brave, endpoint Endpoint, req *http.Request) (*T, error) {
+	ttl := b.cacheTTL(endpoint)
+
+	var (
+		key  string
+		miss bool
+	)
+
+	if ttl > 0 {
+		key = cacheKey(req)
+		if req.Header.Get("Cache-Control") != "no-cache" {
+			if body, ok := b.cache.Get(key); ok {
+				return decodeResult[T](req.Context(), b, endpoint, body, &ResponseMeta{StatusCode: http.StatusOK, Cached: true})
+			}
+
+			miss = true
+		}
+	}
+
+	if b.flights == nil {
+		return fetch[T](b, endpoint, req, key, ttl, miss)
+	}
+
+	v, err := b.flights.do(req.Context(), requestKey(req), func(ctx context.Context) (any, error) {
+		return fetch[T](b, endpoint, req.WithContext(ctx), key, ttl, miss)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*T), nil
+}
+
+// fetch sends the request and decodes the result, storing the response body in
+// the cache under key if ttl is positive. miss reports whether the result was
+// looked up in the cache first.
+func fetch[T any](b *brave, endpoint Endpoint, req *http.Request, key string, ttl time.Duration, miss bool) (*T, error) {
+	ctx := req.Context()
+
+	body, meta, err := roundTrip(b, endpoint, req)
+	if err != nil {
+		return nil, err
+	}
+
+	meta.CacheMiss = miss
+
+	resp, err := decodeResult[T](ctx, b, endpoint, body, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	if ttl > 0 {
+		b.cache.Set(key, body, ttl)
+	}
+
+	return resp, nil
+}
+
+// roundTrip sends the request and reads the body of a successful response.
+func roundTrip(b *brave, endpoint Endpoint, req *http.Request) (_ []byte, _ *ResponseMeta, err error) {
+	ctx, end := b.startPhase(req.Context(), endpoint, PhaseRoundTrip)
+	defer func() { end(err) }()
+
+	req = req.WithContext(ctx)
+
+	var res *http.Response
+	start := time.Now()
+	defer func() { b.logRequest(ctx, endpoint, req, res, time.Since(start), err) }()
+
+	res, err = b.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer res.Body.Close()
+	latency := time.Since(start)
+
+	if res.StatusCode != http.StatusOK {
+		return nil, nil, readError(req, res)
 	}
 
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body, newResponseMeta(res, latency), nil
+}
+
+func decodeResult[T any](ctx context.Context, b *brave, endpoint Endpoint, body []byte, meta *ResponseMeta) (_ *T, err error) {
+	_, end := b.startPhase(ctx, endpoint, PhaseDecode)
+	defer func() { end(err) }()
+
 	var resp T
-	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+	if err := json.Unmarshal(body, &resp); err != nil {
+		b.logDecodeError(ctx, endpoint, err)
 		return nil, err
 	}
 
+	if m, ok := any(&resp).(responseMetaSetter); ok {
+		m.setResponseMeta(meta)
+	}
+
 	return &resp, nil
 }
 
+// readError reads the error of a non-200 response. Responses that do not hold
+// the documented error envelope, such as HTML pages from a proxy or empty
+// bodies, are returned as [HTTPError].
+func readError(req *http.Request, res *http.Response) error {
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+	if err != nil {
+		return err
+	}
+
+	var resp errorResponse
+	if err := json.Unmarshal(body, &resp); err != nil || (resp.Error.Code == "" && resp.Error.Detail == "") {
+		return newHTTPError(req, res, body)
+	}
+
+	if resp.Error.Status == 0 {
+		resp.Error.Status = res.StatusCode
+	}
+
+	resp.Error.Time = resp.Time
+	resp.Error.RawQuery = req.URL.RawQuery
+	return resp.Error
+}
+
 type errorResponse struct {
 	Error ErrorResponse `json:"error"`
 	Time  *Timestamp    `json:"time"`
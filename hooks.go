@@ -0,0 +1,64 @@
+package brave
+
+import "context"
+
+// Phase identifies a stage of a call to the API.
+type Phase string
+
+const (
+	// PhaseBuild covers the construction of the request URL and headers.
+	PhaseBuild Phase = "build"
+
+	// PhaseRoundTrip covers sending the request and reading the response,
+	// including any retries and rate-limiter waits.
+	PhaseRoundTrip Phase = "round_trip"
+
+	// PhaseDecode covers decoding the JSON response body.
+	PhaseDecode Phase = "decode"
+)
+
+// PhaseHook is called when a phase of a call to endpoint starts. It returns
+// the context to use for the phase, and a function that is called with the
+// phase's error, if any, once the phase has ended. The returned function may
+// be nil.
+//
+// Phase hooks allow integrations such as tracing to observe the stages of a
+// call that [Middleware] cannot see.
+type PhaseHook func(ctx context.Context, endpoint Endpoint, phase Phase) (context.Context, func(error))
+
+// WithPhaseHook adds hooks that are called for each phase of every call made
+// by the client. Calling WithPhaseHook multiple times appends to the list.
+func WithPhaseHook(v ...PhaseHook) ClientOption {
+	return func(o clientOptions) clientOptions {
+		hooks := make([]PhaseHook, 0, len(o.phaseHooks)+len(v))
+		hooks = append(hooks, o.phaseHooks...)
+		hooks = append(hooks, v...)
+		o.phaseHooks = hooks
+		return o
+	}
+}
+
+func (b *brave) startPhase(ctx context.Context, endpoint Endpoint, phase Phase) (context.Context, func(error)) {
+	if len(b.phaseHooks) == 0 {
+		return ctx, func(error) {}
+	}
+
+	ends := make([]func(error), 0, len(b.phaseHooks))
+	for _, h := range b.phaseHooks {
+		if h == nil {
+			continue
+		}
+
+		var end func(error)
+		ctx, end = h(ctx, endpoint, phase)
+		if end != nil {
+			ends = append(ends, end)
+		}
+	}
+
+	return ctx, func(err error) {
+		for i := len(ends) - 1; i >= 0; i-- {
+			ends[i](err)
+		}
+	}
+}
@@ -1,47 +1,43 @@
 package brave
 
-import (
-	"context"
-	"net/http"
-
-	"github.com/google/go-querystring/query"
-)
+import "context"
 
 func (b *brave) ImageSearch(ctx context.Context, term string, options ...SearchOption) (*ImageSearchResult, error) {
-	u := *b.baseURL
-	u.Path = u.Path + imageSearchPath
-
 	var opts searchOptions
-	applyOpts(&opts, options, func(o searchOptions) searchOptions {
-		// image search does not support moderate, default to strict.
-		if o.safesearch == SafesearchModerate {
-			o.safesearch = SafesearchStrict
-		}
+	applyOpts(&opts, options, nil)
 
-		return o
-	})
-
-	var params webSearchParams
+	var params imageSearchParams
 	params.fromSearchOptions(term, opts)
 
-	values, err := query.Values(params)
-	if err != nil {
-		return nil, err
-	}
-
-	u.RawQuery = values.Encode()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	opts.applyRequestHeaders(b.subscriptionToken, req)
-
-	return handleRequest[ImageSearchResult](b.client, req)
+	return search[ImageSearchResult](ctx, b, EndpointImageSearch, term, params, opts)
 }
 
 type ImageSearchResult struct {
+	responseMeta
+
 	ResultContainer[ImageResult]
 	Query *Query `json:"query"`
 }
+
+type imageSearchParams struct {
+	Term       string `url:"q"`
+	Country    string `url:"country,omitempty"`
+	SearchLang string `url:"search_lang,omitempty"`
+	Count      int    `url:"count,omitempty"`
+	Safesearch string `url:"safesearch,omitempty"`
+	Spellcheck *bool  `url:"spellcheck,omitempty"`
+}
+
+func (i *imageSearchParams) fromSearchOptions(term string, options searchOptions) {
+	i.Term = term
+	i.Country = options.country
+	i.SearchLang = options.lang
+	i.Count = options.count
+	i.Spellcheck = options.spellcheck
+
+	// image search only supports off and strict, and defaults to strict, so
+	// safesearch is only sent if it was set explicitly.
+	if options.isSet(optSafesearch) {
+		i.Safesearch = options.safesearch.String()
+	}
+}
@@ -0,0 +1,145 @@
+package brave
+
+// LayoutType identifies the kind of result a [LayoutItem] holds. The values
+// match [ResultReference.Type].
+type LayoutType string
+
+const (
+	LayoutTypeWeb         LayoutType = "web"
+	LayoutTypeNews        LayoutType = "news"
+	LayoutTypeVideos      LayoutType = "videos"
+	LayoutTypeFAQ         LayoutType = "faq"
+	LayoutTypeDiscussions LayoutType = "discussions"
+	LayoutTypeInfoBox     LayoutType = "infobox"
+	LayoutTypeLocations   LayoutType = "locations"
+)
+
+// LayoutItem is a single result placed by the mixed layout. Exactly one of the
+// result fields is set, as indicated by Type. The fields point into the
+// containers of the [WebSearchResult] the layout was resolved from.
+type LayoutItem struct {
+	Type LayoutType
+
+	Web        *SearchResult
+	News       *NewsResult
+	Video      *VideoResult
+	FAQ        *QA
+	Discussion *DiscussionResult
+	InfoBox    *GraphInfoBox
+	Location   *LocationResult
+}
+
+// Layout holds the results of a [WebSearchResult] in the order the mixed
+// layout ranks them.
+type Layout struct {
+	Main []LayoutItem
+	Top  []LayoutItem
+	Side []LayoutItem
+
+	// Dangling holds references that could not be resolved, because their
+	// type is unknown, their container is missing, or their index is out of
+	// range.
+	Dangling []DanglingReference
+}
+
+// DanglingReference is a reference of the mixed layout that could not be
+// resolved.
+type DanglingReference struct {
+	// Section is the section of the layout holding the reference: `main`,
+	// `top` or `side`.
+	Section   string
+	Reference ResultReference
+}
+
+// Layout resolves the references of the mixed layout into the results they
+// point to. References with All set expand to every result of their
+// container. References that cannot be resolved are reported in
+// [Layout.Dangling]. If the result has no mixed layout, the returned layout is
+// empty.
+func (w *WebSearchResult) Layout() *Layout {
+	var l Layout
+	if w == nil || w.Mixed == nil {
+		return &l
+	}
+
+	l.Main = w.resolveSection("main", w.Mixed.Main, &l.Dangling)
+	l.Top = w.resolveSection("top", w.Mixed.Top, &l.Dangling)
+	l.Side = w.resolveSection("side", w.Mixed.Side, &l.Dangling)
+
+	return &l
+}
+
+func (w *WebSearchResult) resolveSection(section string, refs []ResultReference, dangling *[]DanglingReference) []LayoutItem {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	items := make([]LayoutItem, 0, len(refs))
+	for _, ref := range refs {
+		resolved, ok := w.resolve(ref)
+		if !ok {
+			*dangling = append(*dangling, DanglingReference{Section: section, Reference: ref})
+			continue
+		}
+
+		items = append(items, resolved...)
+	}
+
+	return items
+}
+
+func (w *WebSearchResult) resolve(ref ResultReference) ([]LayoutItem, bool) {
+	switch LayoutType(ref.Type) {
+	case LayoutTypeWeb:
+		return resolveRef(w.Web, ref, func(r *SearchResult) LayoutItem {
+			return LayoutItem{Type: LayoutTypeWeb, Web: r}
+		})
+	case LayoutTypeNews:
+		return resolveRef(w.News, ref, func(r *NewsResult) LayoutItem {
+			return LayoutItem{Type: LayoutTypeNews, News: r}
+		})
+	case LayoutTypeVideos:
+		return resolveRef(w.Videos, ref, func(r *VideoResult) LayoutItem {
+			return LayoutItem{Type: LayoutTypeVideos, Video: r}
+		})
+	case LayoutTypeFAQ:
+		return resolveRef(w.FAQ, ref, func(r *QA) LayoutItem {
+			return LayoutItem{Type: LayoutTypeFAQ, FAQ: r}
+		})
+	case LayoutTypeDiscussions:
+		return resolveRef(w.Discussions, ref, func(r *DiscussionResult) LayoutItem {
+			return LayoutItem{Type: LayoutTypeDiscussions, Discussion: r}
+		})
+	case LayoutTypeInfoBox:
+		return resolveRef(w.InfoBox, ref, func(r *GraphInfoBox) LayoutItem {
+			return LayoutItem{Type: LayoutTypeInfoBox, InfoBox: r}
+		})
+	case LayoutTypeLocations:
+		return resolveRef(w.Locations, ref, func(r *LocationResult) LayoutItem {
+			return LayoutItem{Type: LayoutTypeLocations, Location: r}
+		})
+	default:
+		return nil, false
+	}
+}
+
+func resolveRef[T any](c *ResultContainer[T], ref ResultReference, item func(*T) LayoutItem) ([]LayoutItem, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	if ref.All {
+		items := make([]LayoutItem, 0, len(c.Results))
+		for i := range c.Results {
+			items = append(items, item(&c.Results[i]))
+		}
+
+		return items, true
+	}
+
+	if ref.Index < 0 || ref.Index >= len(c.Results) {
+		return nil, false
+	}
+
+	return []LayoutItem{item(&c.Results[ref.Index])}, true
+}
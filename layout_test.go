@@ -0,0 +1,62 @@
+package brave_test
+
+import (
+	"context"
+	"testing"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLayout(t *testing.T) {
+	svr := getTestServer("testdata/web_0.json", 200)
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	res, err := client.WebSearch(context.Background(), "speaker of the house")
+	require.Nil(t, err)
+
+	layout := res.Layout()
+	require.Len(t, layout.Main, 15+1+3)
+	assert.Empty(t, layout.Top)
+	assert.Empty(t, layout.Side)
+	assert.Empty(t, layout.Dangling)
+
+	assert.Equal(t, brave.LayoutTypeWeb, layout.Main[0].Type)
+	assert.Same(t, &res.Web.Results[0], layout.Main[0].Web)
+	assert.Equal(t, brave.LayoutTypeInfoBox, layout.Main[1].Type)
+	assert.Same(t, &res.InfoBox.Results[0], layout.Main[1].InfoBox)
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, brave.LayoutTypeVideos, layout.Main[3+i].Type)
+		assert.Same(t, &res.Videos.Results[i], layout.Main[3+i].Video)
+	}
+}
+
+func TestLayoutDangling(t *testing.T) {
+	res := &brave.WebSearchResult{
+		Web: &brave.ResultContainer[brave.SearchResult]{Results: make([]brave.SearchResult, 1)},
+		Mixed: &brave.Mixed{
+			Main: []brave.ResultReference{
+				{Type: "web", Index: 0},
+				{Type: "web", Index: 3},
+				{Type: "news", All: true},
+			},
+			Side: []brave.ResultReference{
+				{Type: "unknown"},
+			},
+		},
+	}
+
+	layout := res.Layout()
+	assert.Len(t, layout.Main, 1)
+	assert.Empty(t, layout.Side)
+	assert.Equal(t, []brave.DanglingReference{
+		{Section: "main", Reference: brave.ResultReference{Type: "web", Index: 3}},
+		{Section: "main", Reference: brave.ResultReference{Type: "news", All: true}},
+		{Section: "side", Reference: brave.ResultReference{Type: "unknown"}},
+	}, layout.Dangling)
+}
@@ -0,0 +1,75 @@
+package brave
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// maxLocationIDs is the maximum number of location IDs accepted by the local
+// endpoints in a single request.
+const maxLocationIDs = 20
+
+func (b *brave) LocalPOIs(ctx context.Context, ids ...string) (*LocalPOIsResult, error) {
+	if !b.skipValidation {
+		if err := checkLocationIDs(ids); err != nil {
+			return nil, err
+		}
+	}
+
+	params := localParams{IDs: ids}
+
+	return search[LocalPOIsResult](ctx, b, EndpointLocalPOIs, strings.Join(ids, ","), params, searchOptions{})
+}
+
+func (b *brave) LocalDescriptions(ctx context.Context, ids ...string) (*LocalDescriptionsResult, error) {
+	if !b.skipValidation {
+		if err := checkLocationIDs(ids); err != nil {
+			return nil, err
+		}
+	}
+
+	params := localParams{IDs: ids}
+
+	return search[LocalDescriptionsResult](ctx, b, EndpointLocalDescriptions, strings.Join(ids, ","), params, searchOptions{})
+}
+
+// checkLocationIDs reports an [ErrorResponse] matching [ErrValidation] if ids
+// is empty or holds more IDs than the API accepts in a single request.
+func checkLocationIDs(ids []string) error {
+	var v validator
+
+	switch {
+	case len(ids) == 0:
+		v.add("ids", "missing", "", "Field required", nil)
+	case len(ids) > maxLocationIDs:
+		v.add("ids", "too_long", strconv.Itoa(len(ids)), fmt.Sprintf("List should have at most %d items", maxLocationIDs), nil)
+	}
+
+	return v.err("")
+}
+
+type LocalPOIsResult struct {
+	responseMeta
+
+	Type    string           `json:"type"`
+	Results []LocationResult `json:"results"`
+}
+
+type LocalDescriptionsResult struct {
+	responseMeta
+
+	Type    string                `json:"type"`
+	Results []LocationDescription `json:"results"`
+}
+
+type LocationDescription struct {
+	Type        string `json:"type"`
+	ID          string `json:"id"`
+	Description string `json:"description"`
+}
+
+type localParams struct {
+	IDs []string `url:"ids"`
+}
@@ -0,0 +1,181 @@
+package brave
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Location describes the geographical location of the client, as sent to the
+// API in the `X-Loc-*` request headers. Zero fields are not sent.
+//
+// Refer to [Query Headers] for more detail.
+//
+// [Query Headers]: https://api.search.brave.com/app/documentation/headers
+type Location struct {
+	Coordinates *Coordinates
+	Timezone    *time.Location
+
+	// City is the generic name of the city.
+	City string
+
+	// State is the ISO 3166-2 code of the state or region, without the country
+	// prefix, e.g. `MI`.
+	State string
+
+	// StateName is the name of the state or region.
+	StateName string
+
+	// Country is the ISO 3166-1 alpha-2 code of the country, e.g. `US`.
+	Country string
+
+	PostalCode string
+}
+
+// Coordinates is a position in degrees.
+type Coordinates struct {
+	Latitude  float32
+	Longitude float32
+}
+
+// WithLocation sets the client's geographical location, replacing any
+// location set by [WithLocLatitude], [WithLocCity] and the other `WithLoc`
+// options.
+//
+// Applicable to [Brave.WebSearch], [Brave.SuggestSearch], [Brave.Spellcheck].
+//
+// Refer to [Query Headers] for more detail.
+//
+// [Query Headers]: https://api.search.brave.com/app/documentation/headers
+func WithLocation(v Location) SearchOption {
+	return func(o searchOptions) searchOptions {
+		o.locLatitude = nil
+		o.locLongitude = nil
+		if v.Coordinates != nil {
+			lat, long := v.Coordinates.Latitude, v.Coordinates.Longitude
+			o.locLatitude = &lat
+			o.locLongitude = &long
+		}
+
+		o.locTimezone = v.Timezone
+		o.locCity = v.City
+		o.locState = v.State
+		o.locStateName = v.StateName
+		o.locCountry = v.Country
+		o.locPostalCode = v.PostalCode
+		return o
+	}
+}
+
+// LocationFromQuery returns the location echoed back by the API in a previous
+// response, so that it can be reused with [WithLocation]. The country is taken
+// from [Query.HeaderCountry], or [Query.Country] if it is not set and is a
+// two-letter country code. It returns an error if the coordinates of the query
+// cannot be parsed.
+func LocationFromQuery(q *Query) (Location, error) {
+	var l Location
+	if q == nil {
+		return l, nil
+	}
+
+	if q.Lat != "" || q.Long != "" {
+		lat, err := strconv.ParseFloat(q.Lat, 32)
+		if err != nil {
+			return l, fmt.Errorf("brave: invalid latitude %q: %w", q.Lat, err)
+		}
+
+		long, err := strconv.ParseFloat(q.Long, 32)
+		if err != nil {
+			return l, fmt.Errorf("brave: invalid longitude %q: %w", q.Long, err)
+		}
+
+		l.Coordinates = &Coordinates{Latitude: float32(lat), Longitude: float32(long)}
+	}
+
+	l.City = q.City
+	l.State = q.State
+	l.PostalCode = q.PostalCode
+
+	// the search country may be `ALL`, which is not a location.
+	l.Country = q.HeaderCountry
+	if l.Country == "" && isCode(q.Country, 2, 2, false) {
+		l.Country = q.Country
+	}
+
+	l.Country = strings.ToUpper(l.Country)
+	return l, nil
+}
+
+// Validate checks that the coordinates of the location are in range and that
+// its country and state are well-formed ISO codes. Errors are reported as an
+// [ErrorResponse] matching [ErrValidation].
+func (l Location) Validate() error {
+	var v validator
+	v.location(l)
+	return v.err("")
+}
+
+// location returns the location set by the location options.
+func (s searchOptions) location() Location {
+	l := Location{
+		Timezone:   s.locTimezone,
+		City:       s.locCity,
+		State:      s.locState,
+		StateName:  s.locStateName,
+		Country:    s.locCountry,
+		PostalCode: s.locPostalCode,
+	}
+
+	if s.locLatitude != nil || s.locLongitude != nil {
+		l.Coordinates = &Coordinates{}
+		if s.locLatitude != nil {
+			l.Coordinates.Latitude = *s.locLatitude
+		}
+
+		if s.locLongitude != nil {
+			l.Coordinates.Longitude = *s.locLongitude
+		}
+	}
+
+	return l
+}
+
+func (v *validator) location(l Location) {
+	if c := l.Coordinates; c != nil {
+		if c.Latitude < -90 || c.Latitude > 90 {
+			v.addHeader("x-loc-lat", "value_error", fmt.Sprintf("%.3f", c.Latitude), "Value error, latitude should be between -90 and 90")
+		}
+
+		if c.Longitude < -180 || c.Longitude > 180 {
+			v.addHeader("x-loc-long", "value_error", fmt.Sprintf("%.3f", c.Longitude), "Value error, longitude should be between -180 and 180")
+		}
+	}
+
+	if l.Country != "" && !isCode(l.Country, 2, 2, false) {
+		v.addHeader("x-loc-country", "value_error", l.Country, "Value error, country should be an ISO 3166-1 alpha-2 code")
+	}
+
+	if l.State != "" && !isCode(l.State, 1, 3, true) {
+		v.addHeader("x-loc-state", "value_error", l.State, "Value error, state should be an ISO 3166-2 subdivision code")
+	}
+}
+
+// isCode reports whether s is made of min to max ASCII letters, or letters
+// and digits if digits is true.
+func isCode(s string, min, max int, digits bool) bool {
+	if len(s) < min || len(s) > max {
+		return false
+	}
+
+	for _, ch := range s {
+		switch {
+		case ch >= 'a' && ch <= 'z', ch >= 'A' && ch <= 'Z':
+		case digits && ch >= '0' && ch <= '9':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
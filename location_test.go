@@ -0,0 +1,132 @@
+package brave_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithLocation(t *testing.T) {
+	var header http.Header
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	tz, err := time.LoadLocation("America/Detroit")
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "pizza",
+		brave.WithLocStateName("Ohio"),
+		brave.WithLocation(brave.Location{
+			Coordinates: &brave.Coordinates{Latitude: 42.331, Longitude: -83.046},
+			Timezone:    tz,
+			City:        "Detroit",
+			State:       "MI",
+			Country:     "US",
+			PostalCode:  "48226",
+		}),
+	)
+	require.Nil(t, err)
+
+	assert.Equal(t, "42.331", header.Get("X-Loc-Lat"))
+	assert.Equal(t, "-83.046", header.Get("X-Loc-Long"))
+	assert.Equal(t, "America/Detroit", header.Get("X-Loc-Timezone"))
+	assert.Equal(t, "Detroit", header.Get("X-Loc-City"))
+	assert.Equal(t, "MI", header.Get("X-Loc-State"))
+	assert.Equal(t, "US", header.Get("X-Loc-Country"))
+	assert.Equal(t, "48226", header.Get("X-Loc-Postal-Code"))
+	assert.Empty(t, header.Get("X-Loc-State-Name"))
+}
+
+func TestLocationFromQuery(t *testing.T) {
+	svr := getTestServer("testdata/web_0.json", 200)
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	res, err := client.WebSearch(context.Background(), "speaker of the house")
+	require.Nil(t, err)
+
+	loc, err := brave.LocationFromQuery(res.Query)
+	require.Nil(t, err)
+
+	require.NotNil(t, loc.Coordinates)
+	assert.Equal(t, float32(180), loc.Coordinates.Latitude)
+	assert.Equal(t, float32(-1.1), loc.Coordinates.Longitude)
+	assert.Equal(t, "Detroit", loc.City)
+	assert.Equal(t, "MI", loc.State)
+	assert.Equal(t, "48999", loc.PostalCode)
+	assert.Equal(t, "US", loc.Country)
+
+	// the fixture's latitude is out of range.
+	err = loc.Validate()
+	assert.ErrorIs(t, err, brave.ErrValidation)
+
+	var resp brave.ErrorResponse
+	require.ErrorAs(t, err, &resp)
+	require.Len(t, resp.Meta.Errors, 1)
+	assert.Equal(t, []string{"header", "x-loc-lat"}, resp.Meta.Errors[0].Loc)
+
+	loc, err = brave.LocationFromQuery(&brave.Query{Country: "ALL", City: "Detroit"})
+	require.Nil(t, err)
+	assert.Equal(t, "", loc.Country)
+	assert.Nil(t, loc.Validate())
+
+	loc, err = brave.LocationFromQuery(&brave.Query{Country: "us"})
+	require.Nil(t, err)
+	assert.Equal(t, "US", loc.Country)
+
+	_, err = brave.LocationFromQuery(&brave.Query{Lat: "north", Long: "1"})
+	assert.NotNil(t, err)
+
+	loc, err = brave.LocationFromQuery(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, loc.Coordinates)
+}
+
+func TestLocationValidation(t *testing.T) {
+	var calls int32
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "pizza", brave.WithLocation(brave.Location{
+		Coordinates: &brave.Coordinates{Latitude: 42.331, Longitude: -200},
+		State:       "Michigan",
+		Country:     "USA",
+	}))
+	assert.ErrorIs(t, err, brave.ErrValidation)
+
+	var resp brave.ErrorResponse
+	require.ErrorAs(t, err, &resp)
+
+	var loc [][]string
+	for _, e := range resp.Meta.Errors {
+		loc = append(loc, e.Loc)
+	}
+
+	assert.Equal(t, [][]string{{"header", "x-loc-long"}, {"header", "x-loc-country"}, {"header", "x-loc-state"}}, loc)
+
+	_, err = client.Spellcheck(context.Background(), "pizza", brave.WithLocLatitude(91))
+	assert.ErrorIs(t, err, brave.ErrValidation)
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+}
@@ -0,0 +1,159 @@
+package brave
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const redacted = "REDACTED"
+
+// WithLogger logs every request sent to the API to l, including the request
+// path, query parameters, headers, response status and latency, and the
+// details of any error. The subscription token is always redacted.
+//
+// Successful requests are logged at [slog.LevelDebug] and failed requests at
+// [slog.LevelWarn], unless configured otherwise with [WithLogLevels].
+// Responses that cannot be decoded are logged at [slog.LevelError].
+//
+// If not provided, nothing is logged.
+func WithLogger(l *slog.Logger) ClientOption {
+	return func(o clientOptions) clientOptions {
+		o.logger = l
+		return o
+	}
+}
+
+// WithLogLevels sets the levels at which successful and failed requests are
+// logged by the logger configured with [WithLogger].
+func WithLogLevels(success, failure slog.Level) ClientOption {
+	return func(o clientOptions) clientOptions {
+		o.logLevels = &logLevels{success: success, failure: failure}
+		return o
+	}
+}
+
+type logLevels struct {
+	success slog.Level
+	failure slog.Level
+}
+
+var defaultLogLevels = logLevels{
+	success: slog.LevelDebug,
+	failure: slog.LevelWarn,
+}
+
+func (b *brave) logRequest(ctx context.Context, endpoint Endpoint, req *http.Request, res *http.Response, latency time.Duration, err error) {
+	if b.logger == nil {
+		return
+	}
+
+	level, msg := b.logLevels.success, "brave request"
+	if err != nil {
+		level, msg = b.logLevels.failure, "brave request failed"
+	}
+
+	if !b.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("endpoint", string(endpoint)),
+		slog.String("path", req.URL.Path),
+		slog.String("params", req.URL.RawQuery),
+		slog.Any("headers", headerAttrs(req.Header)),
+		slog.Duration("latency", latency),
+	}
+
+	if res != nil {
+		attrs = append(attrs, slog.Int("status", res.StatusCode))
+		if id := res.Header.Get("X-Request-Id"); id != "" {
+			attrs = append(attrs, slog.String("request_id", id))
+		}
+	}
+
+	if err != nil {
+		attrs = append(attrs, errorAttrs(err)...)
+	}
+
+	b.logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// logDecodeError logs a response of the API that could not be decoded. Decode
+// failures are always logged at [slog.LevelError], as they indicate a change of
+// the API rather than a failed request.
+func (b *brave) logDecodeError(ctx context.Context, endpoint Endpoint, err error) {
+	if b.logger == nil || err == nil {
+		return
+	}
+
+	b.logger.LogAttrs(ctx, slog.LevelError, "brave response decode failed",
+		slog.String("endpoint", string(endpoint)),
+		slog.String("error", err.Error()),
+	)
+}
+
+// headerAttrs returns the request headers as a group, with the subscription
+// token redacted.
+func headerAttrs(h http.Header) slog.Value {
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	attrs := make([]slog.Attr, 0, len(names))
+	for _, name := range names {
+		v := strings.Join(h[name], ", ")
+		if http.CanonicalHeaderKey(name) == "X-Subscription-Token" {
+			v = redacted
+		}
+
+		attrs = append(attrs, slog.String(name, v))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+func errorAttrs(err error) []slog.Attr {
+	attrs := []slog.Attr{slog.String("error", err.Error())}
+
+	var resp ErrorResponse
+	var httpErr HTTPError
+	switch {
+	case errors.As(err, &resp):
+		attrs = append(attrs,
+			slog.String("error_id", resp.ID),
+			slog.String("error_code", resp.Code),
+		)
+
+		if len(resp.Meta.Errors) > 0 {
+			// details are keyed by index, as handlers may not support
+			// repeated keys.
+			details := make([]slog.Attr, 0, len(resp.Meta.Errors))
+			for i, e := range resp.Meta.Errors {
+				details = append(details, slog.Group(strconv.Itoa(i),
+					slog.String("loc", strings.Join(e.Loc, ".")),
+					slog.String("type", e.Type),
+					slog.String("msg", e.Message),
+					slog.String("input", e.Input),
+				))
+			}
+
+			attrs = append(attrs, slog.Attr{Key: "error_meta", Value: slog.GroupValue(details...)})
+		}
+	case errors.As(err, &httpErr):
+		attrs = append(attrs,
+			slog.String("content_type", httpErr.ContentType),
+			slog.String("body", httpErr.Body),
+		)
+	}
+
+	return attrs
+}
@@ -0,0 +1,126 @@
+package brave_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogger(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"type":"ErrorResponse","error":` + string(errJSON) + `}`))
+	}))
+	defer svr.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	client, err := brave.New("secret-token",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithLogger(logger),
+		brave.WithLogLevels(slog.LevelInfo, slog.LevelError),
+	)
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "speaker of the house", brave.WithLocCity("Detroit"))
+	require.NotNil(t, err)
+
+	assert.NotContains(t, buf.String(), "secret-token")
+
+	var entry struct {
+		Level     string            `json:"level"`
+		Endpoint  string            `json:"endpoint"`
+		Params    string            `json:"params"`
+		Headers   map[string]string `json:"headers"`
+		Status    int               `json:"status"`
+		ErrorCode string            `json:"error_code"`
+	}
+	require.Nil(t, json.Unmarshal(buf.Bytes(), &entry))
+
+	assert.Equal(t, "ERROR", entry.Level)
+	assert.Equal(t, "web/search", entry.Endpoint)
+	assert.Contains(t, entry.Params, "q=speaker+of+the+house")
+	assert.Equal(t, "REDACTED", entry.Headers["X-Subscription-Token"])
+	assert.Equal(t, "Detroit", entry.Headers["X-Loc-City"])
+	assert.Equal(t, 422, entry.Status)
+	assert.Equal(t, "VALIDATION", entry.ErrorCode)
+}
+
+func TestLoggerErrorDetails(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"type":"ErrorResponse","error":{"status":422,"code":"VALIDATION","detail":"Unable to validate request parameter(s)","meta":{"errors":[` +
+			`{"type":"int_parsing","loc":["query","offset"],"msg":"Input should be a valid integer","input":"foo"},` +
+			`{"type":"enum","loc":["query","units"],"msg":"Input should be 'metric' or 'imperial'","input":"bar"}]}}}`))
+	}))
+	defer svr.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithLogger(logger),
+	)
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "speaker of the house")
+	require.NotNil(t, err)
+
+	var entry struct {
+		ErrorMeta map[string]struct {
+			Loc   string `json:"loc"`
+			Input string `json:"input"`
+		} `json:"error_meta"`
+	}
+	require.Nil(t, json.Unmarshal(buf.Bytes(), &entry))
+
+	require.Len(t, entry.ErrorMeta, 2)
+	assert.Equal(t, "query.offset", entry.ErrorMeta["0"].Loc)
+	assert.Equal(t, "query.units", entry.ErrorMeta["1"].Loc)
+	assert.Equal(t, "bar", entry.ErrorMeta["1"].Input)
+}
+
+func TestLoggerDecodeError(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"type": "search", "query": 1`))
+	}))
+	defer svr.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithLogger(logger),
+	)
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "speaker of the house")
+	require.NotNil(t, err)
+
+	var entry struct {
+		Level    string `json:"level"`
+		Msg      string `json:"msg"`
+		Endpoint string `json:"endpoint"`
+		Error    string `json:"error"`
+	}
+	require.Nil(t, json.Unmarshal(buf.Bytes(), &entry))
+
+	assert.Equal(t, "ERROR", entry.Level)
+	assert.Equal(t, "brave response decode failed", entry.Msg)
+	assert.Equal(t, "web/search", entry.Endpoint)
+	assert.NotEmpty(t, entry.Error)
+}
@@ -0,0 +1,119 @@
+package brave
+
+import (
+	"net/http"
+	"time"
+)
+
+// ResponseMeta holds HTTP metadata of the response a result was decoded from.
+type ResponseMeta struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// RequestID is the value of the `X-Request-Id` response header, if any.
+	RequestID string
+
+	// RateLimit holds the parsed `X-RateLimit-*` response headers.
+	RateLimit RateLimit
+
+	// Latency is the time elapsed between sending the request and receiving
+	// the response headers, including any retries.
+	Latency time.Duration
+
+	// Header holds the raw response headers.
+	Header http.Header
+
+	// Cached is true if the result was served from the client's cache, in
+	// which case only StatusCode is set.
+	Cached bool
+
+	// CacheMiss is true if the result was looked up in the client's cache but
+	// not found there. It is false if no cache is configured for the endpoint,
+	// or if the lookup was skipped with [WithNoCache].
+	CacheMiss bool
+}
+
+// RateLimit describes the rate-limit windows of the subscription plan as
+// reported by the API. A window is nil if the API did not report it.
+//
+// Refer to [Rate Limiting] for more detail.
+//
+// [Rate Limiting]: https://api.search.brave.com/app/documentation/rate-limiting
+type RateLimit struct {
+	PerSecond *RateLimitWindow
+	PerMonth  *RateLimitWindow
+}
+
+// RateLimitWindow holds the limit, the remaining requests, and the time until
+// the remaining requests are reset for a single rate-limit window.
+type RateLimitWindow struct {
+	Limit     int
+	Remaining int
+	Reset     time.Duration
+}
+
+func parseRateLimit(h http.Header) RateLimit {
+	limit := parseRateLimitHeader(h.Get("X-RateLimit-Limit"))
+	remaining := parseRateLimitHeader(h.Get("X-RateLimit-Remaining"))
+	reset := parseRateLimitHeader(h.Get("X-RateLimit-Reset"))
+
+	window := func(i int) *RateLimitWindow {
+		if i >= len(limit) && i >= len(remaining) && i >= len(reset) {
+			return nil
+		}
+
+		var w RateLimitWindow
+		if i < len(limit) {
+			w.Limit = limit[i]
+		}
+
+		if i < len(remaining) {
+			w.Remaining = remaining[i]
+		}
+
+		if i < len(reset) {
+			w.Reset = time.Duration(reset[i]) * time.Second
+		}
+
+		return &w
+	}
+
+	return RateLimit{
+		PerSecond: window(0),
+		PerMonth:  window(1),
+	}
+}
+
+func newResponseMeta(res *http.Response, latency time.Duration) *ResponseMeta {
+	return &ResponseMeta{
+		StatusCode: res.StatusCode,
+		RequestID:  res.Header.Get("X-Request-Id"),
+		RateLimit:  parseRateLimit(res.Header),
+		Latency:    latency,
+		Header:     res.Header,
+	}
+}
+
+// responseMeta is embedded in every result type to expose the metadata of the
+// response it was decoded from.
+type responseMeta struct {
+	meta *ResponseMeta
+}
+
+// ResponseMeta returns the HTTP metadata of the response the result was
+// decoded from, or nil if the result was not returned by the client.
+func (r *responseMeta) ResponseMeta() *ResponseMeta {
+	if r == nil {
+		return nil
+	}
+
+	return r.meta
+}
+
+func (r *responseMeta) setResponseMeta(v *ResponseMeta) {
+	r.meta = v
+}
+
+type responseMetaSetter interface {
+	setResponseMeta(*ResponseMeta)
+}
@@ -0,0 +1,80 @@
+package brave
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Request describes a call to one of the methods of [Brave], after its search
+// options have been resolved.
+type Request struct {
+	// Endpoint is the API endpoint being called.
+	Endpoint Endpoint
+
+	// Term is the search term, the summary key for [Brave.SummarizerSearch]
+	// and [Brave.SummarizerStream], or the comma-separated location IDs for
+	// [Brave.LocalPOIs] and [Brave.LocalDescriptions].
+	Term string
+
+	// Params holds the query parameters resolved from the search options.
+	Params url.Values
+
+	// Header holds the request headers resolved from the search options. The
+	// subscription token is added when the request is sent and is not
+	// included.
+	Header http.Header
+}
+
+// Handler performs a call to the API and returns its result, which is a
+// pointer to the result type of the called method, such as *WebSearchResult or
+// *SummaryStream.
+type Handler func(ctx context.Context, req *Request) (any, error)
+
+// Middleware wraps a [Handler] to observe or alter calls to the API. A
+// middleware may modify the request before passing it on, inspect or replace
+// the result and error returned by next, or return a result without calling
+// next at all.
+type Middleware func(next Handler) Handler
+
+// WithMiddleware adds middleware wrapping every call made by the client.
+// Middleware is applied in the order given, with the first being outermost.
+// Calling WithMiddleware multiple times appends to the chain.
+func WithMiddleware(v ...Middleware) ClientOption {
+	return func(o clientOptions) clientOptions {
+		mw := make([]Middleware, 0, len(o.middleware)+len(v))
+		mw = append(mw, o.middleware...)
+		mw = append(mw, v...)
+		o.middleware = mw
+		return o
+	}
+}
+
+// handlerResult returns the result of a [Handler] as a *T. It fails if a
+// middleware returned a result of another type, or no result and no error.
+func handlerResult[T any](endpoint Endpoint, v any, err error) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	res, ok := v.(*T)
+	switch {
+	case !ok && v != nil:
+		return nil, fmt.Errorf("brave: middleware returned %T for %s, expected %T", v, endpoint, res)
+	case res == nil:
+		return nil, fmt.Errorf("brave: middleware returned no result for %s, expected %T", endpoint, res)
+	}
+
+	return res, nil
+}
+
+func (b *brave) chain(h Handler) Handler {
+	for i := len(b.middleware) - 1; i >= 0; i-- {
+		if b.middleware[i] != nil {
+			h = b.middleware[i](h)
+		}
+	}
+
+	return h
+}
@@ -0,0 +1,91 @@
+package brave_test
+
+import (
+	"context"
+	"testing"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware(t *testing.T) {
+	svr := getTestServer("testdata/web_0.json", 200)
+	defer svr.Close()
+
+	var (
+		calls []string
+		seen  *brave.Request
+	)
+
+	record := func(name string) brave.Middleware {
+		return func(next brave.Handler) brave.Handler {
+			return func(ctx context.Context, req *brave.Request) (any, error) {
+				calls = append(calls, name)
+				seen = req
+				return next(ctx, req)
+			}
+		}
+	}
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithMiddleware(record("outer")),
+		brave.WithMiddleware(record("inner")),
+	)
+	require.Nil(t, err)
+
+	res, err := client.WebSearch(context.Background(), "speaker of the house", brave.WithCount(5), brave.WithLocCity("Detroit"))
+	require.Nil(t, err)
+	require.NotNil(t, res)
+
+	assert.Equal(t, []string{"outer", "inner"}, calls)
+	require.NotNil(t, seen)
+	assert.Equal(t, brave.EndpointWebSearch, seen.Endpoint)
+	assert.Equal(t, "speaker of the house", seen.Term)
+	assert.Equal(t, "5", seen.Params.Get("count"))
+	assert.Equal(t, "Detroit", seen.Header.Get("X-Loc-City"))
+	assert.Empty(t, seen.Header.Get("X-Subscription-Token"))
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	canned := &brave.SpellcheckResult{Type: "spellcheck"}
+
+	client, err := brave.New("fake",
+		brave.WithBaseURL("http://127.0.0.1:0/"),
+		brave.WithMiddleware(func(next brave.Handler) brave.Handler {
+			return func(ctx context.Context, req *brave.Request) (any, error) {
+				return canned, nil
+			}
+		}),
+	)
+	require.Nil(t, err)
+
+	res, err := client.Spellcheck(context.Background(), "huose")
+	require.Nil(t, err)
+	assert.Same(t, canned, res)
+
+	_, err = client.WebSearch(context.Background(), "huose")
+	assert.NotNil(t, err)
+}
+
+func TestMiddlewareNilResult(t *testing.T) {
+	client, err := brave.New("fake",
+		brave.WithBaseURL("http://127.0.0.1:0/"),
+		brave.WithMiddleware(func(next brave.Handler) brave.Handler {
+			return func(ctx context.Context, req *brave.Request) (any, error) {
+				return nil, nil
+			}
+		}),
+	)
+	require.Nil(t, err)
+
+	res, err := client.WebSearch(context.Background(), "huose")
+	assert.NotNil(t, err)
+	assert.Nil(t, res)
+
+	stream, err := client.SummarizerStream(context.Background(), "key")
+	assert.NotNil(t, err)
+	assert.Nil(t, stream)
+}
@@ -0,0 +1,46 @@
+package brave
+
+import "context"
+
+func (b *brave) NewsSearch(ctx context.Context, term string, options ...SearchOption) (*NewsSearchResult, error) {
+	var opts searchOptions
+	applyOpts(&opts, options, nil)
+
+	var params newsSearchParams
+	params.fromSearchOptions(term, opts)
+
+	return search[NewsSearchResult](ctx, b, EndpointNewsSearch, term, params, opts)
+}
+
+type NewsSearchResult struct {
+	responseMeta
+
+	ResultContainer[NewsResult]
+	Query *Query `json:"query"`
+}
+
+type newsSearchParams struct {
+	Term          string `url:"q"`
+	Country       string `url:"country,omitempty"`
+	SearchLang    string `url:"search_lang,omitempty"`
+	UILang        string `url:"ui_lang,omitempty"`
+	Count         int    `url:"count,omitempty"`
+	Offset        int    `url:"offset,omitempty"`
+	Safesearch    string `url:"safesearch,omitempty"`
+	Spellcheck    *bool  `url:"spellcheck,omitempty"`
+	Freshness     string `url:"freshness,omitempty"`
+	ExtraSnippets bool   `url:"extra_snippets,omitempty"`
+}
+
+func (n *newsSearchParams) fromSearchOptions(term string, options searchOptions) {
+	n.Term = term
+	n.Country = options.country
+	n.SearchLang = options.lang
+	n.UILang = options.uiLang
+	n.Count = options.count
+	n.Offset = options.offset
+	n.Safesearch = options.safesearch.String()
+	n.Spellcheck = options.spellcheck
+	n.Freshness = options.getFreshness()
+	n.ExtraSnippets = options.extraSnippets
+}
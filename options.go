@@ -0,0 +1,132 @@
+package brave
+
+import (
+	"context"
+	"log/slog"
+)
+
+// optionSet is a set of the search options that map to query parameters.
+// Options that map to request headers apply to every endpoint and are not
+// tracked.
+type optionSet uint32
+
+const (
+	optCount optionSet = 1 << iota
+	optCountry
+	optCustomFreshness
+	optEntityInfo
+	optExtraSnippets
+	optFreshness
+	optGogglesID
+	optLang
+	optOffset
+	optResultFilter
+	optRich
+	optSafesearch
+	optSpellcheck
+	optSummary
+	optTextDecorations
+	optUILang
+	optUnits
+)
+
+// optionNames maps each option to the name of the function setting it.
+var optionNames = []struct {
+	opt  optionSet
+	name string
+}{
+	{optCount, "WithCount"},
+	{optCountry, "WithCountry"},
+	{optCustomFreshness, "WithCustomFreshness"},
+	{optEntityInfo, "WithEntityInfo"},
+	{optExtraSnippets, "WithExtraSnippets"},
+	{optFreshness, "WithFreshness"},
+	{optGogglesID, "WithGogglesID"},
+	{optLang, "WithLang"},
+	{optOffset, "WithOffset"},
+	{optResultFilter, "WithResultFilter"},
+	{optRich, "WithRich"},
+	{optSafesearch, "WithSafesearch"},
+	{optSpellcheck, "WithSpellcheck"},
+	{optSummary, "WithSummary"},
+	{optTextDecorations, "WithTextDecorations"},
+	{optUILang, "WithUILang"},
+	{optUnits, "WithUnits"},
+}
+
+// supportedOptions lists the options accepted by each endpoint. Endpoints that
+// are not listed accept none.
+var supportedOptions = map[Endpoint]optionSet{
+	EndpointWebSearch: optCount | optCountry | optCustomFreshness | optExtraSnippets | optFreshness | optGogglesID |
+		optLang | optOffset | optResultFilter | optSafesearch | optSpellcheck | optSummary | optTextDecorations |
+		optUILang | optUnits,
+	EndpointImageSearch: optCount | optCountry | optLang | optSafesearch | optSpellcheck,
+	EndpointVideoSearch: optCount | optCountry | optCustomFreshness | optFreshness | optLang | optOffset |
+		optSafesearch | optSpellcheck | optUILang,
+	EndpointNewsSearch: optCount | optCountry | optCustomFreshness | optExtraSnippets | optFreshness | optLang |
+		optOffset | optSafesearch | optSpellcheck | optUILang,
+	EndpointSuggestSearch:    optCount | optCountry | optLang | optRich,
+	EndpointSpellcheck:       optCountry | optLang,
+	EndpointSummarizerSearch: optEntityInfo,
+	EndpointSummarizerStream: optEntityInfo,
+}
+
+func (s searchOptions) isSet(opt optionSet) bool {
+	return s.set&opt != 0
+}
+
+func (s optionSet) names() []string {
+	var names []string
+	for _, o := range optionNames {
+		if s&o.opt != 0 {
+			names = append(names, o.name)
+		}
+	}
+
+	return names
+}
+
+// onlyOptions drops the options not in set from the options that are
+// checked by the endpoint. It is used by calls that pass the same options on
+// to several endpoints.
+func onlyOptions(set optionSet) SearchOption {
+	return func(o searchOptions) searchOptions {
+		o.set &= set
+		return o
+	}
+}
+
+// WithStrictOptions controls how the client handles search options that are
+// not supported by the called endpoint, such as [WithRich] passed to
+// [Brave.WebSearch]. If v is true, the call fails with an
+// [UnsupportedOptionError]. Otherwise, the options are ignored and a warning
+// is logged to the logger configured with [WithLogger].
+func WithStrictOptions(v bool) ClientOption {
+	return func(o clientOptions) clientOptions {
+		o.strictOptions = v
+		return o
+	}
+}
+
+// checkOptions reports the options set in opts that endpoint does not
+// support, according to the client's strictness.
+func (b *brave) checkOptions(ctx context.Context, endpoint Endpoint, opts searchOptions) error {
+	unsupported := opts.set &^ supportedOptions[endpoint]
+	if unsupported == 0 {
+		return nil
+	}
+
+	err := UnsupportedOptionError{Endpoint: endpoint, Options: unsupported.names()}
+	if b.strictOptions {
+		return err
+	}
+
+	if b.logger != nil {
+		b.logger.LogAttrs(ctx, slog.LevelWarn, "brave unsupported options ignored",
+			slog.String("endpoint", string(endpoint)),
+			slog.Any("options", err.Options),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package brave_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrictOptions(t *testing.T) {
+	var calls int32
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithStrictOptions(true),
+	)
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "cats", brave.WithRich(true), brave.WithCount(5), brave.WithEntityInfo(true))
+	assert.ErrorIs(t, err, brave.ErrUnsupportedOption)
+
+	var optErr brave.UnsupportedOptionError
+	require.True(t, errors.As(err, &optErr))
+	assert.Equal(t, brave.EndpointWebSearch, optErr.Endpoint)
+	assert.Equal(t, []string{"WithEntityInfo", "WithRich"}, optErr.Options)
+
+	_, err = client.Spellcheck(context.Background(), "cats", brave.WithGogglesID("https://example.com/goggle"))
+	assert.ErrorIs(t, err, brave.ErrUnsupportedOption)
+
+	_, err = client.Spellcheck(context.Background(), "cats", brave.WithCountry("us"), brave.WithLocCity("Detroit"))
+	assert.Nil(t, err)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestUnsupportedOptionsWarning(t *testing.T) {
+	var rawQuery string
+	svr := getQueryServer(t, "", &rawQuery)
+	defer svr.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelWarn}))
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithLogger(logger),
+	)
+	require.Nil(t, err)
+
+	_, err = client.SuggestSearch(context.Background(), "cats", brave.WithRich(true), brave.WithSafesearch(brave.SafesearchOff))
+	require.Nil(t, err)
+	assert.Equal(t, "q=cats&rich=true", rawQuery)
+
+	var entry struct {
+		Level    string   `json:"level"`
+		Endpoint string   `json:"endpoint"`
+		Options  []string `json:"options"`
+	}
+	require.Nil(t, json.Unmarshal(buf.Bytes(), &entry))
+
+	assert.Equal(t, "WARN", entry.Level)
+	assert.Equal(t, "suggest/search", entry.Endpoint)
+	assert.Equal(t, []string{"WithSafesearch"}, entry.Options)
+}
+
+func TestStrictOptionsWebSearchWithSummary(t *testing.T) {
+	var polls int32
+	svr := getSummaryServer(t, 0, &polls)
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithStrictOptions(true),
+		brave.WithSummaryPolling(brave.RetryPolicy{BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}),
+	)
+	require.Nil(t, err)
+
+	_, summary, err := client.WebSearchWithSummary(context.Background(), "speaker of the house", brave.WithCount(5), brave.WithEntityInfo(true))
+	require.Nil(t, err)
+	assert.NotNil(t, summary)
+}
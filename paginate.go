@@ -0,0 +1,162 @@
+package brave
+
+import "context"
+
+// WebSearchPager fetches consecutive pages of web search results. It stops
+// once the API reports that no more results are available, the maximum offset
+// has been reached, or a request fails.
+//
+//	pages := brave.WebSearchPages(ctx, client, "freespoke", brave.WithCount(20))
+//	for pages.Next() {
+//		page := pages.Page()
+//		// ...
+//	}
+//
+//	if err := pages.Err(); err != nil {
+//		// ...
+//	}
+type WebSearchPager struct {
+	ctx     context.Context
+	client  Brave
+	term    string
+	options []SearchOption
+
+	offset int
+	page   *WebSearchResult
+	done   bool
+	err    error
+}
+
+// WebSearchPages returns a [WebSearchPager] paging through the web search
+// results for term, starting at the offset given in options, if any.
+func WebSearchPages(ctx context.Context, client Brave, term string, options ...SearchOption) *WebSearchPager {
+	var opts searchOptions
+	applyOpts(&opts, options, nil)
+
+	return &WebSearchPager{
+		ctx:     ctx,
+		client:  client,
+		term:    term,
+		options: options,
+		offset:  opts.offset,
+	}
+}
+
+// Next fetches the next page, and reports whether one was fetched.
+func (p *WebSearchPager) Next() bool {
+	if p.done {
+		return false
+	}
+
+	if p.offset > maxOffset {
+		p.stop(nil)
+		return false
+	}
+
+	if err := p.ctx.Err(); err != nil {
+		p.stop(err)
+		return false
+	}
+
+	options := make([]SearchOption, 0, len(p.options)+1)
+	options = append(options, p.options...)
+	options = append(options, WithOffset(p.offset))
+
+	page, err := p.client.WebSearch(p.ctx, p.term, options...)
+	if err != nil {
+		p.stop(err)
+		return false
+	}
+
+	p.page = page
+	p.offset++
+
+	if page.Query == nil || !page.Query.MoreResultsAvailable {
+		p.done = true
+	}
+
+	return true
+}
+
+// Page returns the page fetched by the last call to Next.
+func (p *WebSearchPager) Page() *WebSearchResult {
+	return p.page
+}
+
+// Err returns the error that stopped the pager, if any.
+func (p *WebSearchPager) Err() error {
+	return p.err
+}
+
+func (p *WebSearchPager) stop(err error) {
+	p.page = nil
+	p.done = true
+	p.err = err
+}
+
+// WebSearchIterator iterates over the individual web results of consecutive
+// pages of web search results.
+//
+//	it := brave.WebSearchIter(ctx, client, "freespoke", 50)
+//	for it.Next() {
+//		result := it.Result()
+//		// ...
+//	}
+//
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type WebSearchIterator struct {
+	pages   *WebSearchPager
+	limit   int
+	count   int
+	results []SearchResult
+	current SearchResult
+}
+
+// WebSearchIter returns a [WebSearchIterator] over at most limit web results
+// for term, fetching pages as needed. A limit of zero or less means no limit.
+func WebSearchIter(ctx context.Context, client Brave, term string, limit int, options ...SearchOption) *WebSearchIterator {
+	return &WebSearchIterator{
+		pages: WebSearchPages(ctx, client, term, options...),
+		limit: limit,
+	}
+}
+
+// Next advances to the next result, and reports whether there is one.
+func (it *WebSearchIterator) Next() bool {
+	if it.limit > 0 && it.count >= it.limit {
+		return false
+	}
+
+	for len(it.results) == 0 {
+		if !it.pages.Next() {
+			return false
+		}
+
+		if page := it.pages.Page(); page.Web != nil {
+			it.results = page.Web.Results
+		}
+	}
+
+	it.current = it.results[0]
+	it.results = it.results[1:]
+	it.count++
+
+	return true
+}
+
+// Result returns the current result.
+func (it *WebSearchIterator) Result() SearchResult {
+	return it.current
+}
+
+// Page returns the page the current result belongs to.
+func (it *WebSearchIterator) Page() *WebSearchResult {
+	return it.pages.Page()
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *WebSearchIterator) Err() error {
+	return it.pages.Err()
+}
@@ -0,0 +1,101 @@
+package brave_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getPagingServer(t *testing.T, calls *int32) *httptest.Server {
+	pages := map[string]string{
+		"":  "testdata/web_0.json",
+		"1": "testdata/web_1.json",
+		"2": "testdata/web_recipe.json",
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+
+		file, ok := pages[r.URL.Query().Get("offset")]
+		if !ok {
+			t.Errorf("unexpected offset %q", r.URL.Query().Get("offset"))
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+
+		body, err := os.ReadFile(file)
+		require.Nil(t, err)
+		_, _ = w.Write(body)
+	}))
+}
+
+func TestWebSearchPages(t *testing.T) {
+	var calls int32
+	svr := getPagingServer(t, &calls)
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	var counts []int
+	pages := brave.WebSearchPages(context.Background(), client, "speaker of the house")
+	for pages.Next() {
+		counts = append(counts, len(pages.Page().Web.Results))
+	}
+
+	require.Nil(t, pages.Err())
+	assert.Equal(t, []int{15, 20, 1}, counts)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestWebSearchIter(t *testing.T) {
+	var calls int32
+	svr := getPagingServer(t, &calls)
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	var n int
+	it := brave.WebSearchIter(context.Background(), client, "speaker of the house", 20)
+	for it.Next() {
+		assert.NotEmpty(t, it.Result().URL)
+		n++
+	}
+
+	require.Nil(t, it.Err())
+	assert.Equal(t, 20, n)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestWebSearchIterCancel(t *testing.T) {
+	var calls int32
+	svr := getPagingServer(t, &calls)
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var n int
+	it := brave.WebSearchIter(ctx, client, "speaker of the house", 0)
+	for it.Next() {
+		n++
+		if n == 15 {
+			cancel()
+		}
+	}
+
+	assert.ErrorIs(t, it.Err(), context.Canceled)
+	assert.Equal(t, 15, n)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
@@ -0,0 +1,183 @@
+package brave
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket limiting requests per second, combined with a
+// counter for the monthly quota of the subscription plan. Both are adjusted
+// from the `X-RateLimit-*` headers of each response.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	rate   float64
+	tokens float64
+	last   time.Time
+
+	quota     int
+	remaining int
+	resetAt   time.Time
+
+	now func() time.Time
+}
+
+func newRateLimiter(perSecond float64, monthlyQuota int) *rateLimiter {
+	if perSecond <= 0 && monthlyQuota <= 0 {
+		return nil
+	}
+
+	return &rateLimiter{
+		rate:      perSecond,
+		tokens:    burstSize(perSecond),
+		quota:     monthlyQuota,
+		remaining: monthlyQuota,
+		now:       time.Now,
+	}
+}
+
+func burstSize(perSecond float64) float64 {
+	if perSecond < 1 {
+		return 1
+	}
+
+	return perSecond
+}
+
+// wait blocks until a request may be sent. If the context's deadline would
+// pass before then, it fails immediately with [ErrRateLimited].
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := l.now()
+
+	if l.quota > 0 {
+		if l.resetAt.IsZero() {
+			l.resetAt = startOfNextMonth(now)
+		} else if !now.Before(l.resetAt) {
+			l.remaining = l.quota
+			l.resetAt = startOfNextMonth(now)
+		}
+
+		if l.remaining <= 0 {
+			l.mu.Unlock()
+			return ErrQuotaExceeded
+		}
+	}
+
+	var delay time.Duration
+	if l.rate > 0 {
+		l.refill(now)
+		if l.tokens < 1 {
+			delay = time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && deadline.Sub(now) < delay {
+			l.mu.Unlock()
+			return ErrRateLimited
+		}
+
+		// reserve the token now so that concurrent callers queue behind us.
+		l.tokens--
+	}
+
+	if l.quota > 0 {
+		l.remaining--
+	}
+
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// cancel returns a reserved token that was not used.
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate > 0 {
+		l.tokens++
+	}
+
+	if l.quota > 0 {
+		l.remaining++
+	}
+}
+
+func (l *rateLimiter) refill(now time.Time) {
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if burst := burstSize(l.rate); l.tokens > burst {
+			l.tokens = burst
+		}
+	}
+
+	l.last = now
+}
+
+// update adjusts the limiter from the rate-limit headers of a response. Each
+// header holds one value per window, the per-second window first and the
+// monthly window second.
+func (l *rateLimiter) update(h http.Header) {
+	if l == nil {
+		return
+	}
+
+	limit := parseRateLimitHeader(h.Get("X-RateLimit-Limit"))
+	remaining := parseRateLimitHeader(h.Get("X-RateLimit-Remaining"))
+	reset := parseRateLimitHeader(h.Get("X-RateLimit-Reset"))
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	if len(limit) > 0 && limit[0] > 0 && l.rate > 0 {
+		l.refill(now)
+		l.rate = float64(limit[0])
+	}
+
+	if len(remaining) > 0 && remaining[0] == 0 && l.rate > 0 && l.tokens > 0 {
+		l.refill(now)
+		l.tokens = 0
+	}
+
+	if l.quota <= 0 {
+		return
+	}
+
+	if len(limit) > 1 && limit[1] > 0 {
+		l.quota = limit[1]
+	}
+
+	if len(remaining) > 1 {
+		l.remaining = remaining[1]
+	}
+
+	if len(reset) > 1 {
+		l.resetAt = now.Add(time.Duration(reset[1]) * time.Second)
+	}
+}
+
+func startOfNextMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+}
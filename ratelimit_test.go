@@ -0,0 +1,61 @@
+package brave_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitFailsFast(t *testing.T) {
+	svr := getTestServer("testdata/web_0.json", 200)
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithRateLimit(1, 0),
+	)
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "speaker of the house")
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = client.WebSearch(ctx, "speaker of the house")
+	assert.ErrorIs(t, err, brave.ErrRateLimited)
+}
+
+func TestRateLimitQuotaFromHeaders(t *testing.T) {
+	body, err := os.ReadFile("testdata/web_0.json")
+	require.Nil(t, err)
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "20, 2000")
+		w.Header().Set("X-RateLimit-Remaining", "19, 0")
+		w.Header().Set("X-RateLimit-Reset", "1, 86400")
+		_, _ = w.Write(body)
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithRateLimit(20, 2000),
+	)
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "speaker of the house")
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "speaker of the house")
+	assert.ErrorIs(t, err, brave.ErrQuotaExceeded)
+}
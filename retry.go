@@ -0,0 +1,227 @@
+package brave
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultRetryBaseDelay = 250 * time.Millisecond
+	defaultRetryMaxDelay  = 10 * time.Second
+
+	// maxDrainSize limits how much of a discarded response body is read so
+	// that the underlying connection can be reused.
+	maxDrainSize = 64 << 10
+)
+
+// RetryPolicy controls how failed requests to the Brave API are retried.
+//
+// Only idempotent GET requests are retried, and only when the API responded
+// with `429 Too Many Requests` or a transient 5xx status, or when the request
+// failed before a response was received. Validation errors and other 4xx
+// responses are never retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// Values lower than 2 disable retries.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry. Subsequent delays double
+	// up to MaxDelay, with random jitter applied. Defaults to 250ms.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between attempts. If the API asks the client to
+	// wait longer than this (for example because the monthly quota has been
+	// exhausted), the request is not retried. Defaults to 10s.
+	MaxDelay time.Duration
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.BaseDelay <= 0 {
+		p.BaseDelay = defaultRetryBaseDelay
+	}
+
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = defaultRetryMaxDelay
+	}
+
+	if p.MaxDelay < p.BaseDelay {
+		p.MaxDelay = p.BaseDelay
+	}
+
+	return p
+}
+
+// backoff returns the jittered exponential delay before the given retry, where
+// retry 1 is the first retry.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < retry && d < p.MaxDelay; i++ {
+		d *= 2
+	}
+
+	if d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+
+	// equal jitter: wait at least half of the computed delay.
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+// do sends the request, retrying according to the client's retry policy. Every
+// attempt is subject to the client's rate limiter.
+func (b *brave) do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 1; ; attempt++ {
+		if err := b.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		res, err := b.client.Do(req.Clone(ctx))
+		if res != nil {
+			b.limiter.update(res.Header)
+		}
+
+		if attempt >= b.retry.MaxAttempts || req.Method != http.MethodGet || !shouldRetry(ctx, res, err) {
+			return res, err
+		}
+
+		delay, ok := b.retry.delay(attempt, res)
+		if !ok {
+			return res, err
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return res, err
+		}
+
+		if res != nil {
+			_, _ = io.CopyN(io.Discard, res.Body, maxDrainSize)
+			res.Body.Close()
+		}
+
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// delay returns how long to wait before the next attempt. It returns false if
+// the API asked for a longer wait than the policy allows.
+func (p RetryPolicy) delay(attempt int, res *http.Response) (time.Duration, bool) {
+	if res != nil {
+		if d, ok := serverDelay(res); ok {
+			return d, d <= p.MaxDelay
+		}
+	}
+
+	return p.backoff(attempt), true
+}
+
+func shouldRetry(ctx context.Context, res *http.Response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil
+	}
+
+	return isRetryableStatus(res.StatusCode)
+}
+
+// isRetryableStatus reports whether a response with the given status is
+// retried: rate-limited requests and transient server-side failures. Other 5xx
+// statuses, such as `501 Not Implemented`, will fail again.
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// serverDelay reads the delay requested by the API from the `Retry-After`
+// header, falling back to `X-RateLimit-Reset` when the response reports a
+// rate-limit failure. The rate-limit headers are sent with every response, so
+// they are ignored for other failures.
+func serverDelay(res *http.Response) (time.Duration, bool) {
+	h := res.Header
+	if v := h.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(strings.TrimSpace(v)); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+
+		if t, err := http.ParseTime(v); err == nil {
+			d := time.Until(t)
+			if d < 0 {
+				d = 0
+			}
+
+			return d, true
+		}
+	}
+
+	reset := parseRateLimitHeader(h.Get("X-RateLimit-Reset"))
+	if len(reset) == 0 {
+		return 0, false
+	}
+
+	// The API reports one value per window (per second, per month). Wait for
+	// every window that has been exhausted, or the shortest one if the
+	// remaining counts are not known.
+	remaining := parseRateLimitHeader(h.Get("X-RateLimit-Remaining"))
+	secs := -1
+	for i, r := range reset {
+		if i < len(remaining) && remaining[i] == 0 && r > secs {
+			secs = r
+		}
+	}
+
+	if secs < 0 {
+		if res.StatusCode != http.StatusTooManyRequests {
+			return 0, false
+		}
+
+		secs = reset[0]
+		for _, r := range reset[1:] {
+			if r < secs {
+				secs = r
+			}
+		}
+	}
+
+	return time.Duration(secs) * time.Second, true
+}
+
+// parseRateLimitHeader parses comma separated rate-limit header values such as
+// `1, 15000`. Invalid values yield nil.
+func parseRateLimitHeader(v string) []int {
+	if v == "" {
+		return nil
+	}
+
+	parts := strings.Split(v, ",")
+	out := make([]int, 0, len(parts))
+	for _, p := range parts {
+		n, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil {
+			return nil
+		}
+
+		out = append(out, n)
+	}
+
+	return out
+}
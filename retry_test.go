@@ -0,0 +1,151 @@
+package brave_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryRateLimited(t *testing.T) {
+	body, err := os.ReadFile("testdata/web_0.json")
+	require.Nil(t, err)
+
+	var calls int32
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("X-RateLimit-Remaining", "0, 999")
+			w.Header().Set("X-RateLimit-Reset", "0, 1000")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"type":"ErrorResponse","error":{"status":429,"code":"RATE_LIMITED","detail":"Request rate limit exceeded"}}`))
+			return
+		}
+
+		_, _ = w.Write(body)
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithRetryPolicy(brave.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}),
+	)
+	require.Nil(t, err)
+
+	res, err := client.WebSearch(context.Background(), "speaker of the house")
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestRetrySkipsValidationErrors(t *testing.T) {
+	var calls int32
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"type":"ErrorResponse","error":` + string(errJSON) + `}`))
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithRetryPolicy(brave.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}),
+	)
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "speaker of the house")
+	var resp brave.ErrorResponse
+	require.ErrorAs(t, err, &resp)
+	assert.Equal(t, 422, resp.Status)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryRespectsDeadline(t *testing.T) {
+	var calls int32
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "5")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"type":"ErrorResponse","error":{"status":503,"code":"UNAVAILABLE","detail":"unavailable"}}`))
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithRetryPolicy(brave.RetryPolicy{MaxAttempts: 3, MaxDelay: time.Minute}),
+	)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err = client.WebSearch(ctx, "speaker of the house")
+	require.NotNil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryBacksOffServerErrors(t *testing.T) {
+	body, err := os.ReadFile("testdata/web_0.json")
+	require.Nil(t, err)
+
+	var calls int32
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the rate-limit headers are sent with every response.
+		w.Header().Set("X-RateLimit-Remaining", "1, 9000")
+		w.Header().Set("X-RateLimit-Reset", "0, 100000")
+
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"type":"ErrorResponse","error":{"status":503,"code":"UNAVAILABLE","detail":"unavailable"}}`))
+			return
+		}
+
+		_, _ = w.Write(body)
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithRetryPolicy(brave.RetryPolicy{MaxAttempts: 3, BaseDelay: 40 * time.Millisecond}),
+	)
+	require.Nil(t, err)
+
+	start := time.Now()
+	_, err = client.WebSearch(context.Background(), "speaker of the house")
+	require.Nil(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+
+	// two retries wait at least half of 40ms and 80ms.
+	assert.GreaterOrEqual(t, time.Since(start), 60*time.Millisecond)
+}
+
+func TestRetrySkipsNotImplemented(t *testing.T) {
+	var calls int32
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNotImplemented)
+		_, _ = w.Write([]byte(`{"type":"ErrorResponse","error":{"status":501,"code":"NOT_IMPLEMENTED","detail":"not implemented"}}`))
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithRetryPolicy(brave.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}),
+	)
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "speaker of the house")
+	assert.ErrorIs(t, err, brave.ErrUpstream)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
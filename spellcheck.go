@@ -1,40 +1,20 @@
 package brave
 
-import (
-	"context"
-	"net/http"
-
-	"github.com/google/go-querystring/query"
-)
+import "context"
 
 func (b *brave) Spellcheck(ctx context.Context, term string, options ...SearchOption) (*SpellcheckResult, error) {
-	u := *b.baseURL
-	u.Path = u.Path + spellcheckPath
-
 	var opts searchOptions
 	applyOpts(&opts, options, nil)
 
 	var params spellcheckParams
 	params.fromSearchOptions(term, opts)
 
-	values, err := query.Values(params)
-	if err != nil {
-		return nil, err
-	}
-
-	u.RawQuery = values.Encode()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	opts.applyRequestHeaders(b.subscriptionToken, req)
-
-	return handleRequest[SpellcheckResult](b.client, req)
+	return search[SpellcheckResult](ctx, b, EndpointSpellcheck, term, params, opts)
 }
 
 type SpellcheckResult struct {
+	responseMeta
+
 	Type    string                 `json:"type"`
 	Query   *Query                 `json:"query"`
 	Results []SpellcheckResultItem `json:"results"`
@@ -1,40 +1,20 @@
 package brave
 
-import (
-	"context"
-	"net/http"
-
-	"github.com/google/go-querystring/query"
-)
+import "context"
 
 func (b *brave) SuggestSearch(ctx context.Context, term string, options ...SearchOption) (*SuggestSearchResult, error) {
-	u := *b.baseURL
-	u.Path = u.Path + suggestSearchPath
-
 	var opts searchOptions
 	applyOpts(&opts, options, nil)
 
 	var params suggestParams
 	params.fromSearchOptions(term, opts)
 
-	values, err := query.Values(params)
-	if err != nil {
-		return nil, err
-	}
-
-	u.RawQuery = values.Encode()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	opts.applyRequestHeaders(b.subscriptionToken, req)
-
-	return handleRequest[SuggestSearchResult](b.client, req)
+	return search[SuggestSearchResult](ctx, b, EndpointSuggestSearch, term, params, opts)
 }
 
 type SuggestSearchResult struct {
+	responseMeta
+
 	Type    string          `json:"type"`
 	Query   *Query          `json:"query"`
 	Results []SuggestResult `json:"results"`
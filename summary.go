@@ -2,46 +2,51 @@ package brave
 
 import (
 	"context"
-	"net/http"
+	"fmt"
+	"time"
+)
 
-	"github.com/google/go-querystring/query"
+// Summary statuses reported in [SummarizerSearchResult.Status].
+const (
+	SummaryStatusComplete = "complete"
+	SummaryStatusFailed   = "failed"
 )
 
-func (b *brave) SummarizerSearch(ctx context.Context, key string, options ...SearchOption) (*SummarizerSearchResult, error) {
-	u := *b.baseURL
-	u.Path = u.Path + summarizerSearchPath
+// defaultSummaryPolls is the number of polls of [Brave.WaitForSummary] unless
+// configured otherwise with [WithSummaryPolling].
+const defaultSummaryPolls = 10
 
+func (b *brave) SummarizerSearch(ctx context.Context, key string, options ...SearchOption) (*SummarizerSearchResult, error) {
 	var opts searchOptions
 	applyOpts(&opts, options, nil)
 
 	var params summarizerSearchParams
 	params.fromSearchOptions(key, opts)
 
-	values, err := query.Values(params)
-	if err != nil {
-		return nil, err
-	}
-
-	u.RawQuery = values.Encode()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	return search[SummarizerSearchResult](ctx, b, EndpointSummarizerSearch, key, params, opts)
+}
 
-	opts.applyRequestHeaders(b.subscriptionToken, req)
+type SummarizerSearchResult struct {
+	responseMeta
 
-	return handleRequest[SummarizerSearchResult](b.client, req)
+	Type         string                `json:"type"`
+	Status       string                `json:"status"`
+	Title        string                `json:"title"`
+	Summary      []SummaryMessage      `json:"summary"`
+	Enrichments  *SummaryEnrichments   `json:"enrichments"`
+	Followups    []string              `json:"followups"`
+	EntitiesInfo map[string]EntityInfo `json:"entities_info"`
 }
 
-type SummarizerSearchResult struct {
-	Type         string              `json:"type"`
-	Status       string              `json:"status"`
-	Title        string              `json:"title"`
-	Summary      []SummaryMessage    `json:"summary"`
-	Enrichments  *SummaryEnrichments `json:"enrichments"`
-	Followups    []string            `json:"followups"`
-	EntitiesInfo map[string]any      `json:"entities_info"`
+// EntityInfo returns the info of the entity with the given UUID, as found in
+// [SummaryEntity.UUID], if the summary holds it.
+func (r *SummarizerSearchResult) EntityInfo(uuid string) (EntityInfo, bool) {
+	if r == nil {
+		return EntityInfo{}, false
+	}
+
+	info, ok := r.EntitiesInfo[uuid]
+	return info, ok
 }
 
 type summarizerSearchParams struct {
@@ -53,3 +58,77 @@ func (s *summarizerSearchParams) fromSearchOptions(key string, options searchOpt
 	s.Key = key
 	s.EntityInfo = options.entityInfo
 }
+
+// withPollDefaults returns the policy with the defaults of [RetryPolicy] and a
+// default number of polls.
+func (p RetryPolicy) withPollDefaults() RetryPolicy {
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = defaultSummaryPolls
+	}
+
+	return p.withDefaults()
+}
+
+func (b *brave) WaitForSummary(ctx context.Context, key string, options ...SearchOption) (*SummarizerSearchResult, error) {
+	for poll := 1; ; poll++ {
+		res, err := b.SummarizerSearch(ctx, key, options...)
+		if err != nil {
+			return nil, err
+		}
+
+		switch res.Status {
+		case SummaryStatusComplete:
+			return res, nil
+		case SummaryStatusFailed:
+			return res, ErrSummaryFailed
+		}
+
+		if b.summaryPoll.MaxAttempts > 0 && poll >= b.summaryPoll.MaxAttempts {
+			return res, fmt.Errorf("%w: status %q after %d polls", ErrSummaryPending, res.Status, poll)
+		}
+
+		t := time.NewTimer(b.summaryPoll.backoff(poll))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return res, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+func (b *brave) WebSearchWithSummary(ctx context.Context, term string, options ...SearchOption) (*WebSearchResult, *SummarizerSearchResult, error) {
+	webOpts := make([]SearchOption, 0, len(options)+2)
+	webOpts = append(webOpts, options...)
+	webOpts = append(webOpts, WithSummary(true), onlyOptions(supportedOptions[EndpointWebSearch]))
+
+	web, err := b.WebSearch(ctx, term, webOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := summaryKey(web)
+	if key == "" {
+		return web, nil, nil
+	}
+
+	summaryOpts := make([]SearchOption, 0, len(options)+1)
+	summaryOpts = append(summaryOpts, options...)
+	summaryOpts = append(summaryOpts, onlyOptions(supportedOptions[EndpointSummarizerSearch]))
+
+	summary, err := b.WaitForSummary(ctx, key, summaryOpts...)
+	return web, summary, err
+}
+
+// summaryKey returns the key of the summary offered with res, if any.
+func summaryKey(res *WebSearchResult) string {
+	if res.Summarizer != nil && res.Summarizer.Key != "" {
+		return res.Summarizer.Key
+	}
+
+	if res.Query != nil {
+		return res.Query.SummaryKey
+	}
+
+	return ""
+}
@@ -0,0 +1,276 @@
+package brave
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// maxStreamEventSize limits the size of a single line of a streamed summary.
+const maxStreamEventSize = 1 << 20
+
+// streamDone marks the end of a streamed summary.
+var streamDone = []byte("[DONE]")
+
+func (b *brave) SummarizerStream(ctx context.Context, key string, options ...SearchOption) (*SummaryStream, error) {
+	var opts searchOptions
+	applyOpts(&opts, options, nil)
+
+	if err := b.checkOptions(ctx, EndpointSummarizerStream, opts); err != nil {
+		return nil, err
+	}
+
+	var params summarizerSearchParams
+	params.fromSearchOptions(key, opts)
+
+	values, err := query.Values(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if !b.skipValidation {
+		if err := validateOptions(EndpointSummarizerStream, opts, values.Encode()); err != nil {
+			return nil, err
+		}
+	}
+
+	header := make(http.Header)
+	opts.applyRequestHeaders(header)
+	header.Set("Accept", "text/event-stream")
+
+	r := &Request{
+		Endpoint: EndpointSummarizerStream,
+		Term:     key,
+		Params:   values,
+		Header:   header,
+	}
+
+	v, err := b.chain(b.openStream)(ctx, r)
+	return handlerResult[SummaryStream](r.Endpoint, v, err)
+}
+
+// openStream sends the request and returns a stream reading the body of a
+// successful response. Streams bypass the cache and request coalescing.
+func (b *brave) openStream(ctx context.Context, r *Request) (_ any, err error) {
+	req, err := b.newRequest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, end := b.startPhase(req.Context(), r.Endpoint, PhaseRoundTrip)
+	defer func() { end(err) }()
+
+	req = req.WithContext(ctx)
+
+	var res *http.Response
+	start := time.Now()
+	defer func() { b.logRequest(ctx, r.Endpoint, req, res, time.Since(start), err) }()
+
+	res, err = b.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		defer res.Body.Close()
+		return nil, readError(req, res)
+	}
+
+	_, endPhase := b.startPhase(ctx, r.Endpoint, PhaseDecode)
+	endDecode := func(err error) {
+		b.logDecodeError(ctx, r.Endpoint, err)
+		endPhase(err)
+	}
+
+	scanner := bufio.NewScanner(res.Body)
+	scanner.Buffer(make([]byte, 0, 4096), maxStreamEventSize)
+
+	stream := &SummaryStream{
+		body:    res.Body,
+		scanner: scanner,
+		end:     endDecode,
+	}
+
+	stream.result.Type = "summarizer"
+	stream.result.setResponseMeta(newResponseMeta(res, time.Since(start)))
+	return stream, nil
+}
+
+// SummaryStream reads a summary streamed by [Brave.SummarizerStream] as it is
+// generated, one message at a time. The stream must be closed once done with.
+//
+//	stream, err := client.SummarizerStream(ctx, key)
+//	if err != nil {
+//		// ...
+//	}
+//
+//	defer stream.Close()
+//
+//	for stream.Next() {
+//		msg := stream.Message()
+//		// ...
+//	}
+//
+//	if err := stream.Err(); err != nil {
+//		// ...
+//	}
+//
+//	summary := stream.Result()
+type SummaryStream struct {
+	body    io.ReadCloser
+	scanner *bufio.Scanner
+	end     func(error)
+
+	data    []byte
+	message SummaryMessage
+	result  SummarizerSearchResult
+	done    bool
+	err     error
+}
+
+// summaryStreamEvent is the payload of a streamed event. Events of type
+// `summarizer` carry the fields of the summary other than its messages, and
+// all other events are messages of the summary.
+type summaryStreamEvent struct {
+	SummarizerSearchResult
+
+	Data string `json:"data"`
+}
+
+// Next reads the next message of the summary, and reports whether there is
+// one.
+func (s *SummaryStream) Next() bool {
+	for !s.done {
+		event, ok := s.readEvent()
+		if !ok {
+			return false
+		}
+
+		var e summaryStreamEvent
+		if err := json.Unmarshal(event, &e); err != nil {
+			s.stop(err)
+			return false
+		}
+
+		if e.Type == "summarizer" {
+			s.mergeResult(&e.SummarizerSearchResult)
+			continue
+		}
+
+		s.message = SummaryMessage{Type: e.Type, Data: e.Data}
+		s.result.Summary = append(s.result.Summary, s.message)
+		return true
+	}
+
+	return false
+}
+
+// readEvent returns the data of the next event of the stream.
+func (s *SummaryStream) readEvent() ([]byte, bool) {
+	s.data = s.data[:0]
+
+	for s.scanner.Scan() {
+		line := s.scanner.Bytes()
+
+		if len(line) == 0 {
+			if len(s.data) > 0 {
+				break
+			}
+
+			continue
+		}
+
+		field, value, _ := bytes.Cut(line, []byte(":"))
+		if string(field) != "data" {
+			// comments, event names and retry hints carry no content.
+			continue
+		}
+
+		if len(s.data) > 0 {
+			s.data = append(s.data, '\n')
+		}
+
+		s.data = append(s.data, bytes.TrimPrefix(value, []byte(" "))...)
+	}
+
+	if err := s.scanner.Err(); err != nil {
+		s.stop(err)
+		return nil, false
+	}
+
+	if len(s.data) == 0 || bytes.Equal(s.data, streamDone) {
+		s.stop(nil)
+		return nil, false
+	}
+
+	return s.data, true
+}
+
+func (s *SummaryStream) mergeResult(r *SummarizerSearchResult) {
+	if r.Status != "" {
+		s.result.Status = r.Status
+	}
+
+	if r.Title != "" {
+		s.result.Title = r.Title
+	}
+
+	if r.Enrichments != nil {
+		s.result.Enrichments = r.Enrichments
+	}
+
+	if r.Followups != nil {
+		s.result.Followups = r.Followups
+	}
+
+	if r.EntitiesInfo != nil {
+		s.result.EntitiesInfo = r.EntitiesInfo
+	}
+
+	s.result.Summary = append(s.result.Summary, r.Summary...)
+}
+
+// Message returns the message read by the last call to Next.
+func (s *SummaryStream) Message() SummaryMessage {
+	return s.message
+}
+
+// Result returns the summary aggregated from the messages read so far. Once
+// Next has returned false without an error, it holds the complete summary.
+func (s *SummaryStream) Result() *SummarizerSearchResult {
+	return &s.result
+}
+
+// Err returns the error that stopped the stream, if any.
+func (s *SummaryStream) Err() error {
+	return s.err
+}
+
+// Close closes the underlying response body, ending the stream. It is safe to
+// call Close more than once.
+func (s *SummaryStream) Close() error {
+	if !s.done {
+		s.done = true
+		s.end(nil)
+	}
+
+	return s.body.Close()
+}
+
+// stop ends the stream once it has been read to the end or has failed.
+func (s *SummaryStream) stop(err error) {
+	s.done = true
+	s.err = err
+
+	if err == nil && s.result.Status == "" {
+		s.result.Status = SummaryStatusComplete
+	}
+
+	s.end(err)
+}
@@ -0,0 +1,96 @@
+package brave_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizerStream(t *testing.T) {
+	body, err := os.ReadFile("testdata/summarizer_stream.txt")
+	require.Nil(t, err)
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/summarizer/summary_streaming", r.URL.Path)
+		assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+		assert.Equal(t, "key", r.URL.Query().Get("key"))
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write(body)
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	stream, err := client.SummarizerStream(context.Background(), "key")
+	require.Nil(t, err)
+	defer stream.Close()
+
+	var (
+		types []string
+		text  strings.Builder
+	)
+
+	for stream.Next() {
+		msg := stream.Message()
+		types = append(types, msg.Type)
+		if msg.Type == "token" {
+			text.WriteString(msg.Data)
+		}
+	}
+
+	require.Nil(t, stream.Err())
+	assert.Equal(t, []string{"token", "token", "inline_reference", "token"}, types)
+	assert.Equal(t, "The Speaker of the House is the presiding officer of the United States House of Representatives.", text.String())
+
+	res := stream.Result()
+	assert.Equal(t, brave.SummaryStatusComplete, res.Status)
+	assert.Equal(t, "Speaker of the House", res.Title)
+	assert.Len(t, res.Summary, 4)
+	assert.Equal(t, []string{"who is the current speaker of the house"}, res.Followups)
+	require.NotNil(t, res.ResponseMeta())
+	assert.Equal(t, http.StatusOK, res.ResponseMeta().StatusCode)
+}
+
+func TestSummarizerStreamError(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"type":"ErrorResponse","error":{"id":"f49c8ffa-5ddc-4fbf-9841-6b3093c21eb2","status":422,"code":"VALIDATION","detail":"Unable to validate request parameter(s)"}}`))
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	stream, err := client.SummarizerStream(context.Background(), "key")
+	assert.Nil(t, stream)
+	assert.ErrorIs(t, err, brave.ErrValidation)
+}
+
+func TestSummarizerStreamMalformed(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("data: {\"type\":\"token\",\"data\":\"The\"}\n\ndata: {\"type\":\n\n"))
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	stream, err := client.SummarizerStream(context.Background(), "key")
+	require.Nil(t, err)
+	defer stream.Close()
+
+	assert.True(t, stream.Next())
+	assert.Equal(t, "The", stream.Message().Data)
+	assert.False(t, stream.Next())
+	assert.NotNil(t, stream.Err())
+	assert.Empty(t, stream.Result().Status)
+}
@@ -0,0 +1,240 @@
+package brave_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getSummaryServer returns a server that answers web searches with a summary
+// key, and reports the summary as pending for the given number of polls before
+// completing it.
+func getSummaryServer(t *testing.T, pending int32, polls *int32) *httptest.Server {
+	body, err := os.ReadFile("testdata/summarizer.json")
+	require.Nil(t, err)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/web/search":
+			assert.Equal(t, "true", r.URL.Query().Get("summary"))
+			_, _ = w.Write([]byte(`{"type":"search","query":{"original":"speaker of the house"},"summarizer":{"type":"summarizer","key":"{\"query\": \"speaker of the house\"}"}}`))
+		case "/summarizer/search":
+			assert.Equal(t, `{"query": "speaker of the house"}`, r.URL.Query().Get("key"))
+			if atomic.AddInt32(polls, 1) <= pending {
+				_, _ = w.Write([]byte(`{"type":"summarizer","status":"pending"}`))
+				return
+			}
+
+			_, _ = w.Write(body)
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestWaitForSummary(t *testing.T) {
+	var polls int32
+	svr := getSummaryServer(t, 2, &polls)
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithSummaryPolling(brave.RetryPolicy{BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}),
+	)
+	require.Nil(t, err)
+
+	res, err := client.WaitForSummary(context.Background(), `{"query": "speaker of the house"}`)
+	require.Nil(t, err)
+
+	assert.Equal(t, brave.SummaryStatusComplete, res.Status)
+	assert.Equal(t, "Speaker of the House", res.Title)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&polls))
+}
+
+func TestWaitForSummaryMaxAttempts(t *testing.T) {
+	var polls int32
+	svr := getSummaryServer(t, 5, &polls)
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithSummaryPolling(brave.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}),
+	)
+	require.Nil(t, err)
+
+	res, err := client.WaitForSummary(context.Background(), `{"query": "speaker of the house"}`)
+	assert.ErrorIs(t, err, brave.ErrSummaryPending)
+	require.NotNil(t, res)
+	assert.Equal(t, "pending", res.Status)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&polls))
+}
+
+func TestWaitForSummaryDefaultAttempts(t *testing.T) {
+	var polls int32
+	svr := getSummaryServer(t, 1000, &polls)
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithSummaryPolling(brave.RetryPolicy{BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}),
+	)
+	require.Nil(t, err)
+
+	_, err = client.WaitForSummary(context.Background(), `{"query": "speaker of the house"}`)
+	assert.ErrorIs(t, err, brave.ErrSummaryPending)
+	assert.Equal(t, int32(10), atomic.LoadInt32(&polls))
+}
+
+func TestWaitForSummaryContext(t *testing.T) {
+	var polls int32
+	svr := getSummaryServer(t, 1000, &polls)
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = client.WaitForSummary(ctx, `{"query": "speaker of the house"}`)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWaitForSummaryUnbounded(t *testing.T) {
+	var polls int32
+	svr := getSummaryServer(t, 1000, &polls)
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithSummaryPolling(brave.RetryPolicy{MaxAttempts: -1, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}),
+	)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = client.WaitForSummary(ctx, `{"query": "speaker of the house"}`)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Greater(t, atomic.LoadInt32(&polls), int32(10))
+}
+
+func TestWaitForSummaryFailed(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"type":"summarizer","status":"failed"}`))
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	res, err := client.WaitForSummary(context.Background(), "key")
+	assert.ErrorIs(t, err, brave.ErrSummaryFailed)
+	require.NotNil(t, res)
+	assert.Equal(t, brave.SummaryStatusFailed, res.Status)
+}
+
+func TestWebSearchWithSummary(t *testing.T) {
+	var polls int32
+	svr := getSummaryServer(t, 1, &polls)
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithSummaryPolling(brave.RetryPolicy{BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}),
+	)
+	require.Nil(t, err)
+
+	web, summary, err := client.WebSearchWithSummary(context.Background(), "speaker of the house")
+	require.Nil(t, err)
+
+	require.NotNil(t, web)
+	assert.Equal(t, "speaker of the house", web.Query.Original)
+	require.NotNil(t, summary)
+	assert.Equal(t, brave.SummaryStatusComplete, summary.Status)
+	assert.Len(t, summary.Followups, 2)
+}
+
+func TestWebSearchWithoutSummary(t *testing.T) {
+	svr := getTestServer("testdata/web_recipe.json", 200)
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	web, summary, err := client.WebSearchWithSummary(context.Background(), "recipe")
+	require.Nil(t, err)
+	assert.NotNil(t, web)
+	assert.Nil(t, summary)
+}
+
+func TestEntityInfo(t *testing.T) {
+	svr := getTestServer("testdata/summarizer.json", 200)
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	res, err := client.SummarizerSearch(context.Background(), "key", brave.WithEntityInfo(true))
+	require.Nil(t, err)
+	require.NotNil(t, res.Enrichments)
+	require.Len(t, res.Enrichments.Entities, 1)
+
+	info, ok := res.EntityInfo(res.Enrichments.Entities[0].UUID)
+	require.True(t, ok)
+	assert.Equal(t, "Wikipedia", info.Provider)
+	assert.Equal(t, "The United States House of Representatives is the lower chamber of the United States Congress.", info.Description)
+
+	assert.Equal(t, "United States House of Representatives", info.Title)
+	assert.Equal(t, "https://en.wikipedia.org/wiki/United_States_House_of_Representatives", info.URL)
+	require.NotNil(t, info.Thumbnail)
+	assert.Equal(t, "https://imgs.search.brave.com/capitol-thumb.jpg", info.Thumbnail.Src)
+	assert.Equal(t, "https://upload.wikimedia.org/capitol.jpg", info.Thumbnail.Original)
+	require.Len(t, info.Images, 1)
+	assert.Equal(t, "https://imgs.search.brave.com/capitol.jpg", info.Images[0].Src)
+	require.Len(t, info.Profiles, 1)
+	assert.Equal(t, "Wikipedia", info.Profiles[0].Name)
+	assert.Equal(t, "https://imgs.search.brave.com/wikipedia.png", info.Profiles[0].Image)
+	assert.NotEmpty(t, info.Raw)
+
+	_, ok = res.EntityInfo("unknown")
+	assert.False(t, ok)
+}
+
+func TestEntityInfoMalformed(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"type":"summarizer","status":"complete","entities_info":{` +
+			`"a":{"provider":"Wikipedia","images":"https://imgs.search.brave.com/capitol.jpg"},` +
+			`"b":{"provider":"Wikipedia","description":"Valid"}}}`))
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	res, err := client.SummarizerSearch(context.Background(), "key", brave.WithEntityInfo(true))
+	require.Nil(t, err)
+
+	info, ok := res.EntityInfo("a")
+	require.True(t, ok)
+	assert.Empty(t, info.Provider)
+	assert.JSONEq(t, `{"provider":"Wikipedia","images":"https://imgs.search.brave.com/capitol.jpg"}`, string(info.Raw))
+
+	info, ok = res.EntityInfo("b")
+	require.True(t, ok)
+	assert.Equal(t, "Valid", info.Description)
+}
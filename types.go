@@ -1,6 +1,7 @@
 package brave
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -84,11 +85,12 @@ type Profile struct {
 
 type NewsResult struct {
 	Result
-	MetaURL   MetaURL    `json:"meta_url"`
-	Source    string     `json:"source"`
-	Breaking  bool       `json:"breaking"`
-	Thumbnail *Thumbnail `json:"thumbnail"`
-	Age       *Timestamp `json:"age"`
+	MetaURL       MetaURL    `json:"meta_url"`
+	Source        string     `json:"source"`
+	Breaking      bool       `json:"breaking"`
+	Thumbnail     *Thumbnail `json:"thumbnail"`
+	Age           *Timestamp `json:"age"`
+	ExtraSnippets []string   `json:"extra_snippets"`
 }
 
 type VideoResult struct {
@@ -255,6 +257,9 @@ type ImageProperties struct {
 type LocationResult struct {
 	Result
 
+	// ID identifies the location for [Brave.LocalPOIs] and
+	// [Brave.LocalDescriptions]. It is only valid for a limited time.
+	ID             string          `json:"id"`
 	Type           string          `json:"type"`
 	ProviderURL    string          `json:"provider_url"`
 	Coordinates    []float32       `json:"coordinates"`
@@ -506,6 +511,38 @@ type SummaryEntity struct {
 	Highlight []TextLocation `json:"highlight"`
 }
 
+// EntityInfo describes an entity of a summary, as returned when
+// [WithEntityInfo] is used. It is linked to a [SummaryEntity] by its UUID, see
+// [SummarizerSearchResult.EntityInfo].
+type EntityInfo struct {
+	Title       string      `json:"title"`
+	Description string      `json:"description"`
+	Provider    string      `json:"provider"`
+	URL         string      `json:"url"`
+	Thumbnail   *Thumbnail  `json:"thumbnail"`
+	Images      []Thumbnail `json:"images"`
+	Profiles    []Profile   `json:"profiles"`
+
+	// Raw holds the undecoded entity info, including any fields not covered
+	// above. If the fields above cannot be decoded, for example because the
+	// API changed their type, only Raw is set.
+	Raw json.RawMessage `json:"-"`
+}
+
+func (e *EntityInfo) UnmarshalJSON(in []byte) error {
+	type entityInfo EntityInfo
+
+	// a single malformed entity should not fail the whole summary.
+	var info entityInfo
+	if err := json.Unmarshal(in, &info); err != nil {
+		info = entityInfo{}
+	}
+
+	*e = EntityInfo(info)
+	e.Raw = append(json.RawMessage(nil), in...)
+	return nil
+}
+
 type SummaryContext struct {
 	Title   string   `json:"title"`
 	URL     string   `json:"url"`
@@ -37,4 +37,28 @@ func TestErrorResponse(t *testing.T) {
 	)
 }
 
+func TestErrorResponseClass(t *testing.T) {
+	var resp brave.ErrorResponse
+	require.Nil(t, json.Unmarshal(errJSON, &resp))
+	assert.ErrorIs(t, resp, brave.ErrValidation)
+	assert.False(t, brave.IsRetryable(resp))
+
+	cases := []struct {
+		resp      brave.ErrorResponse
+		class     error
+		retryable bool
+	}{
+		{brave.ErrorResponse{Status: 429, Code: "RATE_LIMITED"}, brave.ErrRateLimited, true},
+		{brave.ErrorResponse{Status: 429, Code: "QUOTA_LIMITED"}, brave.ErrQuotaExceeded, false},
+		{brave.ErrorResponse{Status: 401, Code: "SUBSCRIPTION_TOKEN_INVALID"}, brave.ErrUnauthorized, false},
+		{brave.ErrorResponse{Status: 503}, brave.ErrUpstream, true},
+	}
+
+	for _, c := range cases {
+		err := fmt.Errorf("search: %w", c.resp)
+		assert.ErrorIs(t, err, c.class, c.resp.Code)
+		assert.Equal(t, c.retryable, brave.IsRetryable(err), c.resp.Code)
+	}
+}
+
 var errJSON = []byte(`{"id": "f49c8ffa-5ddc-4fbf-9841-6b3093c21eb2","status": 422,"code": "VALIDATION","detail": "Unable to validate request parameter(s)","meta": {"errors": [{"type": "int_parsing","loc": ["query","offset"],"msg": "Input should be a valid integer, unable to parse string as an integer","input": "foo"}]}}`)
@@ -0,0 +1,200 @@
+package brave
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// maxOffset is the highest page offset accepted by the endpoints supporting
+// [WithOffset].
+const maxOffset = 9
+
+// maxCount is the highest count accepted by each endpoint supporting
+// [WithCount].
+var maxCount = map[Endpoint]int{
+	EndpointWebSearch:     20,
+	EndpointImageSearch:   200,
+	EndpointVideoSearch:   50,
+	EndpointNewsSearch:    50,
+	EndpointSuggestSearch: 20,
+}
+
+// validationDetail matches the detail of the validation errors returned by
+// the API.
+const validationDetail = "Unable to validate request parameter(s)"
+
+// validator collects parameter errors in the shape the API reports them.
+type validator struct {
+	errs []ErrorMetaError
+}
+
+func (v *validator) add(param, typ, input, msg string, enum []string) {
+	v.addAt([]string{"query", param}, typ, input, msg, enum)
+}
+
+func (v *validator) addHeader(name, typ, input, msg string) {
+	v.addAt([]string{"header", name}, typ, input, msg, nil)
+}
+
+func (v *validator) addAt(loc []string, typ, input, msg string, enum []string) {
+	v.errs = append(v.errs, ErrorMetaError{
+		Loc:     loc,
+		Message: msg,
+		Type:    typ,
+		Context: ErrorContext{EnumValues: enum},
+		Input:   input,
+	})
+}
+
+func (v *validator) enum(param, input string, values []string) {
+	for _, value := range values {
+		if strings.EqualFold(input, value) {
+			return
+		}
+	}
+
+	v.add(param, "enum", input, "Input should be "+quoteList(values), values)
+}
+
+func (v *validator) rangeOf(param string, input, min, max int) {
+	switch {
+	case input < min:
+		v.add(param, "greater_than_equal", strconv.Itoa(input), fmt.Sprintf("Input should be greater than or equal to %d", min), nil)
+	case input > max:
+		v.add(param, "less_than_equal", strconv.Itoa(input), fmt.Sprintf("Input should be less than or equal to %d", max), nil)
+	}
+}
+
+// err returns the collected errors as an [ErrorResponse] matching
+// [ErrValidation], or nil if there are none.
+func (v *validator) err(rawQuery string) error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+
+	return ErrorResponse{
+		Status:   http.StatusUnprocessableEntity,
+		Code:     codeValidation,
+		Detail:   validationDetail,
+		Meta:     ErrorMeta{Component: "client", Errors: v.errs},
+		RawQuery: rawQuery,
+	}
+}
+
+// WithoutLocalValidation disables checking search options against the ranges
+// and values documented by the API before requests are sent. Requests are sent
+// as is and invalid options are left for the API to reject, which allows
+// passing values the API accepts but this package does not know about yet.
+//
+// If not provided, search options are validated, and invalid options fail with
+// an [ErrorResponse] matching [ErrValidation] without a request being sent.
+func WithoutLocalValidation() ClientOption {
+	return func(o clientOptions) clientOptions {
+		o.skipValidation = true
+		return o
+	}
+}
+
+// validateOptions checks the options supported by endpoint against the ranges
+// and values documented by the API, so that invalid requests fail without
+// being sent. Unsupported options are not sent, and are not checked. The
+// location headers are sent to every endpoint, and are always checked.
+func validateOptions(endpoint Endpoint, opts searchOptions, rawQuery string) error {
+	var v validator
+
+	set := opts.set & supportedOptions[endpoint]
+
+	if set&optCount != 0 {
+		v.rangeOf("count", opts.count, 1, maxCount[endpoint])
+	}
+
+	if set&optOffset != 0 {
+		v.rangeOf("offset", opts.offset, 0, maxOffset)
+	}
+
+	if set&optCountry != 0 {
+		v.enum("country", opts.country, stringValues(countries))
+	}
+
+	if set&optLang != 0 {
+		param := "search_lang"
+		if endpoint == EndpointSuggestSearch || endpoint == EndpointSpellcheck {
+			param = "lang"
+		}
+
+		v.enum(param, opts.lang, stringValues(searchLangs))
+	}
+
+	if set&optUILang != 0 {
+		v.enum("ui_lang", opts.uiLang, stringValues(uiLangs))
+	}
+
+	if set&optSafesearch != 0 {
+		safesearch := []Safesearch{SafesearchOff, SafesearchModerate, SafesearchStrict}
+		if endpoint == EndpointImageSearch {
+			safesearch = []Safesearch{SafesearchOff, SafesearchStrict}
+		}
+
+		values := make([]string, 0, len(safesearch))
+		valid := false
+		for _, s := range safesearch {
+			values = append(values, s.String())
+			valid = valid || s == opts.safesearch
+		}
+
+		if !valid {
+			v.add("safesearch", "enum", opts.safesearch.String(), "Input should be "+quoteList(values), values)
+		}
+	}
+
+	if set&optFreshness != 0 && opts.freshness != FreshnessNone && opts.freshness.String() == "" {
+		v.add("freshness", "enum", strconv.Itoa(int(opts.freshness)), "Input should be 'pd', 'pw', 'pm', 'py' or a date range", nil)
+	}
+
+	if set&optCustomFreshness != 0 && opts.freshness == FreshnessNone {
+		if start, end := opts.customFreshness[0], opts.customFreshness[1]; end.Before(start) {
+			v.add("freshness", "value_error", opts.getFreshness(), "Value error, the end of the date range is before its start", nil)
+		}
+	}
+
+	if set&optResultFilter != 0 {
+		for _, f := range opts.resultFilter {
+			if f.String() == "" {
+				v.add("result_filter", "enum", string(f), "Input should be a known result type", nil)
+			}
+		}
+	}
+
+	if set&optUnits != 0 && opts.units != UnitTypeNone && opts.units.String() == "" {
+		v.add("units", "enum", strconv.Itoa(int(opts.units)), "Input should be 'metric' or 'imperial'", []string{"metric", "imperial"})
+	}
+
+	v.location(opts.location())
+
+	return v.err(rawQuery)
+}
+
+func stringValues[T ~string](values []T) []string {
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = string(v)
+	}
+
+	return strs
+}
+
+// quoteList formats values like the enum errors of the API: 'a', 'b' or 'c'.
+func quoteList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = "'" + value + "'"
+	}
+
+	if len(quoted) < 2 {
+		return strings.Join(quoted, "")
+	}
+
+	return strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1]
+}
@@ -0,0 +1,164 @@
+package brave_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"dev.freespoke.com/brave-search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateOptions(t *testing.T) {
+	var calls int32
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	web := func(options ...brave.SearchOption) error {
+		_, err := client.WebSearch(context.Background(), "cats", options...)
+		return err
+	}
+
+	news := func(options ...brave.SearchOption) error {
+		_, err := client.NewsSearch(context.Background(), "cats", options...)
+		return err
+	}
+
+	videos := func(options ...brave.SearchOption) error {
+		_, err := client.VideoSearch(context.Background(), "cats", options...)
+		return err
+	}
+
+	images := func(options ...brave.SearchOption) error {
+		_, err := client.ImageSearch(context.Background(), "cats", options...)
+		return err
+	}
+
+	spellcheck := func(options ...brave.SearchOption) error {
+		_, err := client.Spellcheck(context.Background(), "cats", options...)
+		return err
+	}
+
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		search  func(options ...brave.SearchOption) error
+		options []brave.SearchOption
+		loc     [][]string
+	}{
+		{
+			name:    "web count",
+			search:  web,
+			options: []brave.SearchOption{brave.WithCount(500)},
+			loc:     [][]string{{"query", "count"}},
+		},
+		{
+			name:    "negative offset",
+			search:  news,
+			options: []brave.SearchOption{brave.WithOffset(-1)},
+			loc:     [][]string{{"query", "offset"}},
+		},
+		{
+			name:    "custom freshness",
+			search:  videos,
+			options: []brave.SearchOption{brave.WithCustomFreshness(now, now.AddDate(0, 0, -7))},
+			loc:     [][]string{{"query", "freshness"}},
+		},
+		{
+			name:    "country and lang",
+			search:  spellcheck,
+			options: []brave.SearchOption{brave.WithCountry("XX"), brave.WithLang("en-US")},
+			loc:     [][]string{{"query", "country"}, {"query", "lang"}},
+		},
+		{
+			name:    "ui lang",
+			search:  web,
+			options: []brave.SearchOption{brave.WithUILang("en")},
+			loc:     [][]string{{"query", "ui_lang"}},
+		},
+		{
+			name:    "image safesearch",
+			search:  images,
+			options: []brave.SearchOption{brave.WithSafesearch(brave.SafesearchModerate)},
+			loc:     [][]string{{"query", "safesearch"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.search(tt.options...)
+			assert.ErrorIs(t, err, brave.ErrValidation)
+
+			var resp brave.ErrorResponse
+			require.ErrorAs(t, err, &resp)
+			assert.Equal(t, http.StatusUnprocessableEntity, resp.Status)
+			assert.Equal(t, "VALIDATION", resp.Code)
+
+			var loc [][]string
+			for _, e := range resp.Meta.Errors {
+				loc = append(loc, e.Loc)
+			}
+
+			assert.Equal(t, tt.loc, loc)
+		})
+	}
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+
+	err = web(
+		brave.WithCount(20),
+		brave.WithOffset(9),
+		brave.WithCountry("us"),
+		brave.WithLang("en"),
+		brave.WithUILang("en-US"),
+		brave.WithCustomFreshness(now.AddDate(0, 0, -7), now),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestValidateResultFilter(t *testing.T) {
+	var rawQuery string
+	svr := getQueryServer(t, "", &rawQuery)
+	defer svr.Close()
+
+	client, err := brave.New("fake", brave.WithHTTPClient(svr.Client()), brave.WithBaseURL(svr.URL))
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "cats", brave.WithResultFilter(brave.ResultFilterWeb, brave.ResultFilterImages))
+	require.Nil(t, err)
+	assert.Contains(t, rawQuery, "result_filter=web%2Cimages")
+
+	_, err = client.WebSearch(context.Background(), "cats", brave.WithResultFilter("locations", "query", "summarizer", "rich"))
+	require.Nil(t, err)
+	assert.Contains(t, rawQuery, "result_filter=locations%2Cquery%2Csummarizer%2Crich")
+}
+
+func TestWithoutLocalValidation(t *testing.T) {
+	var rawQuery string
+	svr := getQueryServer(t, "", &rawQuery)
+	defer svr.Close()
+
+	client, err := brave.New("fake",
+		brave.WithHTTPClient(svr.Client()),
+		brave.WithBaseURL(svr.URL),
+		brave.WithoutLocalValidation(),
+	)
+	require.Nil(t, err)
+
+	_, err = client.WebSearch(context.Background(), "cats", brave.WithCount(50), brave.WithResultFilter("unknown"))
+	require.Nil(t, err)
+	assert.Contains(t, rawQuery, "count=50")
+	assert.Contains(t, rawQuery, "result_filter=unknown")
+}
@@ -1,40 +1,44 @@
 package brave
 
-import (
-	"context"
-	"net/http"
-
-	"github.com/google/go-querystring/query"
-)
+import "context"
 
 func (b *brave) VideoSearch(ctx context.Context, term string, options ...SearchOption) (*VideoSearchResult, error) {
-	u := *b.baseURL
-	u.Path = u.Path + videoSearchPath
-
 	var opts searchOptions
 	applyOpts(&opts, options, nil)
 
-	var params webSearchParams
+	var params videoSearchParams
 	params.fromSearchOptions(term, opts)
 
-	values, err := query.Values(params)
-	if err != nil {
-		return nil, err
-	}
-
-	u.RawQuery = values.Encode()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	opts.applyRequestHeaders(b.subscriptionToken, req)
-
-	return handleRequest[VideoSearchResult](b.client, req)
+	return search[VideoSearchResult](ctx, b, EndpointVideoSearch, term, params, opts)
 }
 
 type VideoSearchResult struct {
+	responseMeta
+
 	ResultContainer[VideoResult]
 	Query *Query `json:"query"`
 }
+
+type videoSearchParams struct {
+	Term       string `url:"q"`
+	Country    string `url:"country,omitempty"`
+	SearchLang string `url:"search_lang,omitempty"`
+	UILang     string `url:"ui_lang,omitempty"`
+	Count      int    `url:"count,omitempty"`
+	Offset     int    `url:"offset,omitempty"`
+	Safesearch string `url:"safesearch,omitempty"`
+	Spellcheck *bool  `url:"spellcheck,omitempty"`
+	Freshness  string `url:"freshness,omitempty"`
+}
+
+func (v *videoSearchParams) fromSearchOptions(term string, options searchOptions) {
+	v.Term = term
+	v.Country = options.country
+	v.SearchLang = options.lang
+	v.UILang = options.uiLang
+	v.Count = options.count
+	v.Offset = options.offset
+	v.Safesearch = options.safesearch.String()
+	v.Spellcheck = options.spellcheck
+	v.Freshness = options.getFreshness()
+}
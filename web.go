@@ -1,40 +1,20 @@
 package brave
 
-import (
-	"context"
-	"net/http"
-
-	"github.com/google/go-querystring/query"
-)
+import "context"
 
 func (b *brave) WebSearch(ctx context.Context, term string, options ...SearchOption) (*WebSearchResult, error) {
-	u := *b.baseURL
-	u.Path = u.Path + webSearchPath
-
 	var opts searchOptions
 	applyOpts(&opts, options, nil)
 
 	var params webSearchParams
 	params.fromSearchOptions(term, opts)
 
-	values, err := query.Values(params)
-	if err != nil {
-		return nil, err
-	}
-
-	u.RawQuery = values.Encode()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	opts.applyRequestHeaders(b.subscriptionToken, req)
-
-	return handleRequest[WebSearchResult](b.client, req)
+	return search[WebSearchResult](ctx, b, EndpointWebSearch, term, params, opts)
 }
 
 type WebSearchResult struct {
+	responseMeta
+
 	Type        string                             `json:"type"`
 	Discussions *ResultContainer[DiscussionResult] `json:"discussions"`
 	FAQ         *ResultContainer[QA]               `json:"faq"`